@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &AutoRenewalPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &AutoRenewalPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &AutoRenewalPolicyResource{}
+
+// autoRenewalPolicyID is the ID of every auto renewal policy; a policy covers
+// the whole account, so there is nothing else to identify it by.
+const autoRenewalPolicyID = "auto_renewal_policy"
+
+type AutoRenewalPolicyResource struct {
+	client Route53DomainsAPI
+}
+
+type AutoRenewalPolicyResourceModel struct {
+	ID                 tftypes.String   `tfsdk:"id"`
+	WithinDays         tftypes.Int64    `tfsdk:"within_days"`
+	DurationYears      tftypes.Int64    `tfsdk:"duration_years"`
+	ExcludeDomainNames []tftypes.String `tfsdk:"exclude_domain_names"`
+	DueDomains         tftypes.List     `tfsdk:"due_domains"`
+	RenewedDomains     tftypes.Map      `tfsdk:"renewed_domains"`
+}
+
+func NewAutoRenewalPolicyResource() resource.Resource {
+	return &AutoRenewalPolicyResource{}
+}
+
+func (r *AutoRenewalPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auto_renewal_policy"
+}
+
+func (r *AutoRenewalPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renews every domain in the account that expires within within_days and does not have auto-renew enabled. Each plan lists the domains and, when any are due, plans an update that renews them. Destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Always auto_renewal_policy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"within_days": schema.Int64Attribute{
+				Required:    true,
+				Description: "Renew domains expiring within this many days, including domains that have already expired.",
+			},
+			"duration_years": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "Number of years (1-10) to renew each domain for. Defaults to 1.",
+			},
+			"exclude_domain_names": schema.ListAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Domains never renewed by this policy, such as domains left to expire.",
+			},
+			"due_domains": schema.ListAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "Domains, sorted by name, that the policy would renew now. Refreshed on every read.",
+			},
+			"renewed_domains": schema.MapAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "The renewal operation ID of every domain this policy has renewed, keyed by domain name. A domain renewed again keeps its latest operation.",
+			},
+		},
+	}
+}
+
+func (r *AutoRenewalPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AutoRenewalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.WithinDays.IsNull() && !data.WithinDays.IsUnknown() && data.WithinDays.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("within_days"),
+			"Invalid within_days",
+			fmt.Sprintf("within_days must be at least 1, got %d.", data.WithinDays.ValueInt64()),
+		)
+	}
+	if years := data.DurationYears.ValueInt64(); !data.DurationYears.IsNull() && !data.DurationYears.IsUnknown() && (years < 1 || years > 10) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("duration_years"),
+			"Invalid duration_years",
+			fmt.Sprintf("duration_years must be between 1 and 10, got %d.", years),
+		)
+	}
+}
+
+func (r *AutoRenewalPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.DomainsClient
+}
+
+// ModifyPlan lists the domains due for renewal. When there are any, the plan
+// warns about the paid renewals and leaves due_domains and renewed_domains
+// unknown, which is what makes Create or Update renew them. Otherwise nothing
+// is renewed and the plan is empty.
+func (r *AutoRenewalPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan AutoRenewalPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.WithinDays.IsUnknown() || slices.ContainsFunc(plan.ExcludeDomainNames, tftypes.String.IsUnknown) {
+		return
+	}
+
+	due, err := r.dueDomains(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing domains",
+			fmt.Sprintf("Could not list the domains in the account to find those due for renewal: %s", err.Error()),
+		)
+		return
+	}
+
+	if len(due) > 0 {
+		names := domainSummaryNames(due)
+		resp.Diagnostics.AddWarning(
+			"Domains will be renewed",
+			fmt.Sprintf("Applying this plan renews %d domain(s) expiring within %d days for %d year(s) each, charged at each TLD's renewal price: %s.", len(due), plan.WithinDays.ValueInt64(), plan.DurationYears.ValueInt64(), strings.Join(names, ", ")),
+		)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("due_domains"), tftypes.ListUnknown(tftypes.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("renewed_domains"), tftypes.MapUnknown(tftypes.StringType))...)
+		return
+	}
+
+	renewed := tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{})
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("renewed_domains"), &renewed)...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("due_domains"), tftypes.ListValueMust(tftypes.StringType, []attr.Value{}))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("renewed_domains"), renewed)...)
+}
+
+func (r *AutoRenewalPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AutoRenewalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = tftypes.StringValue(autoRenewalPolicyID)
+	r.apply(ctx, &data, map[string]attr.Value{}, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutoRenewalPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AutoRenewalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	due, err := r.dueDomains(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing domains",
+			fmt.Sprintf("Could not list the domains in the account to find those due for renewal: %s", err.Error()),
+		)
+		return
+	}
+	data.DueDomains = stringList(domainSummaryNames(due))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutoRenewalPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AutoRenewalPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = tftypes.StringValue(autoRenewalPolicyID)
+	prior := map[string]attr.Value{}
+	if !state.RenewedDomains.IsNull() {
+		prior = state.RenewedDomains.Elements()
+	}
+	r.apply(ctx, &data, prior, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AutoRenewalPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Auto renewal policy will be removed from state only")
+}
+
+// apply renews the due domains when the plan left renewed_domains unknown,
+// adding them to the prior renewals, and otherwise keeps the planned values.
+// A domain that fails to renew is reported and stays in due_domains, while
+// the others are still renewed and recorded.
+func (r *AutoRenewalPolicyResource) apply(ctx context.Context, data *AutoRenewalPolicyResourceModel, prior map[string]attr.Value, diags *diag.Diagnostics) {
+	if !data.RenewedDomains.IsUnknown() {
+		return
+	}
+
+	renewed := make(map[string]attr.Value, len(prior))
+	for name, operationID := range prior {
+		renewed[name] = operationID
+	}
+	data.RenewedDomains = tftypes.MapValueMust(tftypes.StringType, renewed)
+	data.DueDomains = tftypes.ListValueMust(tftypes.StringType, []attr.Value{})
+
+	due, err := r.dueDomains(ctx, *data)
+	if err != nil {
+		diags.AddError(
+			"Error listing domains",
+			fmt.Sprintf("Could not list the domains in the account to find those due for renewal: %s", err.Error()),
+		)
+		return
+	}
+
+	years := data.DurationYears.ValueInt64()
+	var remaining []string
+	for _, domain := range due {
+		domainName := aws.ToString(domain.DomainName)
+		operationID, err := r.renew(ctx, domainName, *domain.Expiry, years)
+		switch {
+		case errors.Is(err, errWaitTimeout):
+			// Recorded all the same: the operation was accepted, and a retry
+			// would renew the domain a second time once it completes
+			diags.AddWarning(
+				"Domain renewal still in progress",
+				fmt.Sprintf("Renewal of %s (operation %s) did not complete within %s.", domainName, operationID, renewalTimeout),
+			)
+		case err != nil:
+			diags.AddError(
+				"Error renewing domain",
+				fmt.Sprintf("Could not renew %s for %d year(s): %s", domainName, years, err.Error()),
+			)
+			remaining = append(remaining, domainName)
+			continue
+		}
+		renewed[domainName] = tftypes.StringValue(operationID)
+	}
+
+	data.RenewedDomains = tftypes.MapValueMust(tftypes.StringType, renewed)
+	data.DueDomains = stringList(remaining)
+}
+
+// renew renews a domain expiring at expiry and waits for the operation,
+// returning its ID. errWaitTimeout means the renewal was accepted but had
+// not completed within renewalTimeout.
+func (r *AutoRenewalPolicyResource) renew(ctx context.Context, domainName string, expiry time.Time, years int64) (string, error) {
+	tflog.Info(ctx, "Renewing domain", map[string]interface{}{
+		"domain":      domainName,
+		"years":       years,
+		"expiry_year": expiry.Year(),
+	})
+
+	output, err := r.client.RenewDomain(ctx, &route53domains.RenewDomainInput{
+		DomainName:        aws.String(domainName),
+		DurationInYears:   aws.Int32(int32(years)),
+		CurrentExpiryYear: int32(expiry.Year()),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	operationID := aws.ToString(output.OperationId)
+	opDetail, err := waitForOperation(ctx, r.client, operationID, renewalTimeout)
+	switch {
+	case errors.Is(err, errWaitTimeout):
+		return operationID, errWaitTimeout
+	case err != nil:
+		return operationID, fmt.Errorf("could not check the status of operation %s: %w", operationID, err)
+	case opDetail.Status != types.OperationStatusSuccessful:
+		return operationID, fmt.Errorf("operation %s finished with status %s: %s", operationID, opDetail.Status, aws.ToString(opDetail.Message))
+	}
+	return operationID, nil
+}
+
+// dueDomains lists the domains in the account and returns those the policy
+// renews now.
+func (r *AutoRenewalPolicyResource) dueDomains(ctx context.Context, data AutoRenewalPolicyResourceModel) ([]types.DomainSummary, error) {
+	domains, err := listAllDomains(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude := make([]string, 0, len(data.ExcludeDomainNames))
+	for _, name := range data.ExcludeDomainNames {
+		exclude = append(exclude, canonicalDomainName(name.ValueString()))
+	}
+	window := time.Duration(data.WithinDays.ValueInt64()) * 24 * time.Hour
+	return domainsDue(domains, time.Now(), window, exclude), nil
+}
+
+// domainsDue returns the domains, sorted by name, that expire before
+// now+window and are not in exclude. Domains with auto-renew enabled are
+// skipped, as Route 53 renews them itself, as are domains without a reported
+// expiry.
+func domainsDue(domains []types.DomainSummary, now time.Time, window time.Duration, exclude []string) []types.DomainSummary {
+	cutoff := now.Add(window)
+
+	var due []types.DomainSummary
+	for _, domain := range domains {
+		if domain.DomainName == nil || domain.Expiry == nil || aws.ToBool(domain.AutoRenew) {
+			continue
+		}
+		if !slices.Contains(exclude, canonicalDomainName(*domain.DomainName)) && domain.Expiry.Before(cutoff) {
+			due = append(due, domain)
+		}
+	}
+	slices.SortFunc(due, func(a, b types.DomainSummary) int {
+		return strings.Compare(aws.ToString(a.DomainName), aws.ToString(b.DomainName))
+	})
+	return due
+}
+
+// listAllDomains returns every domain registered in the account.
+func listAllDomains(ctx context.Context, client Route53DomainsAPI) ([]types.DomainSummary, error) {
+	paginator := route53domains.NewListDomainsPaginator(client, &route53domains.ListDomainsInput{})
+
+	var domains []types.DomainSummary
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, page.Domains...)
+	}
+	return domains, nil
+}
+
+func domainSummaryNames(domains []types.DomainSummary) []string {
+	names := make([]string, len(domains))
+	for i, domain := range domains {
+		names[i] = aws.ToString(domain.DomainName)
+	}
+	return names
+}
+
+// stringList returns values as a list, empty rather than null when there are
+// none.
+func stringList(values []string) tftypes.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = tftypes.StringValue(v)
+	}
+	return tftypes.ListValueMust(tftypes.StringType, elements)
+}
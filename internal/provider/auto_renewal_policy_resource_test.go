@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testAutoRenewalPolicyModel() *AutoRenewalPolicyResourceModel {
+	return &AutoRenewalPolicyResourceModel{
+		ID:                 tftypes.StringUnknown(),
+		WithinDays:         tftypes.Int64Value(30),
+		DurationYears:      tftypes.Int64Value(1),
+		ExcludeDomainNames: []tftypes.String{stringValue("excluded.com")},
+		DueDomains:         tftypes.ListUnknown(tftypes.StringType),
+		RenewedDomains:     tftypes.MapUnknown(tftypes.StringType),
+	}
+}
+
+// testAccountDomains are the domains of a test account, relative to now: two
+// due for renewal within 30 days, and one each that is not due, renews itself
+// or is excluded.
+func testAccountDomains(now time.Time) []types.DomainSummary {
+	return []types.DomainSummary{
+		{DomainName: aws.String("later.com"), Expiry: aws.Time(now.AddDate(0, 0, 100)), AutoRenew: aws.Bool(false)},
+		{DomainName: aws.String("soon.com"), Expiry: aws.Time(now.AddDate(0, 0, 10)), AutoRenew: aws.Bool(false)},
+		{DomainName: aws.String("self-renewing.com"), Expiry: aws.Time(now.AddDate(0, 0, 5)), AutoRenew: aws.Bool(true)},
+		{DomainName: aws.String("excluded.com"), Expiry: aws.Time(now.AddDate(0, 0, 3)), AutoRenew: aws.Bool(false)},
+		{DomainName: aws.String("expired.com"), Expiry: aws.Time(now.AddDate(0, 0, -2)), AutoRenew: aws.Bool(false)},
+	}
+}
+
+// mockRenewalClient lists domains across two pages and renews them, failing
+// renewals of the domains in fail. The renewed domains are appended to
+// renewed.
+func mockRenewalClient(domains []types.DomainSummary, renewed *[]*route53domains.RenewDomainInput, fail ...string) *MockRoute53DomainsClient {
+	return &MockRoute53DomainsClient{
+		ListDomainsFunc: func(ctx context.Context, params *route53domains.ListDomainsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListDomainsOutput, error) {
+			if params.Marker == nil {
+				return &route53domains.ListDomainsOutput{Domains: domains[:2], NextPageMarker: aws.String("page-2")}, nil
+			}
+			return &route53domains.ListDomainsOutput{Domains: domains[2:]}, nil
+		},
+		RenewDomainFunc: func(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error) {
+			if slices.Contains(fail, aws.ToString(params.DomainName)) {
+				return nil, errors.New("TLD does not support renewal")
+			}
+			*renewed = append(*renewed, params)
+			return &route53domains.RenewDomainOutput{OperationId: aws.String("op-" + aws.ToString(params.DomainName))}, nil
+		},
+		GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+			return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: types.OperationStatusSuccessful}, nil
+		},
+	}
+}
+
+func TestDomainsDue(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	domains := append(testAccountDomains(now),
+		types.DomainSummary{DomainName: aws.String("no-expiry.com"), AutoRenew: aws.Bool(false)},
+		types.DomainSummary{DomainName: aws.String("Excluded.net"), Expiry: aws.Time(now.AddDate(0, 0, 1))},
+	)
+
+	tests := []struct {
+		name    string
+		window  time.Duration
+		exclude []string
+		want    []string
+	}{
+		{"within 30 days", 30 * 24 * time.Hour, []string{"excluded.com", "excluded.net"}, []string{"expired.com", "soon.com"}},
+		{"within a year", 365 * 24 * time.Hour, []string{"excluded.com", "excluded.net"}, []string{"expired.com", "later.com", "soon.com"}},
+		{"nothing excluded", 30 * 24 * time.Hour, nil, []string{"Excluded.net", "excluded.com", "expired.com", "soon.com"}},
+		{"only expired", time.Hour, []string{"excluded.net"}, []string{"expired.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domainSummaryNames(domainsDue(domains, now, tt.window, tt.exclude))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAutoRenewalPolicy_planAndCreate(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	now := time.Now()
+	domains := testAccountDomains(now)
+	var renewed []*route53domains.RenewDomainInput
+	r := &AutoRenewalPolicyResource{client: mockRenewalClient(domains, &renewed)}
+
+	plan := newResourcePlan(t, r, testAutoRenewalPolicyModel())
+	planResp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: newResourceState(t, r, nil), Plan: plan}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan returned errors: %v", planResp.Diagnostics)
+	}
+	warnings := planResp.Diagnostics.Warnings()
+	if len(warnings) != 1 || warnings[0].Summary() != "Domains will be renewed" || !strings.Contains(warnings[0].Detail(), "expired.com, soon.com") {
+		t.Fatalf("Expected a warning naming the due domains, got %v", planResp.Diagnostics)
+	}
+	if len(renewed) != 0 {
+		t.Fatalf("Expected no renewals during plan, got %d", len(renewed))
+	}
+
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: planResp.Plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if len(renewed) != 2 {
+		t.Fatalf("Expected 2 renewals, got %d", len(renewed))
+	}
+	for i, want := range []types.DomainSummary{domains[4], domains[1]} {
+		if aws.ToString(renewed[i].DomainName) != aws.ToString(want.DomainName) || renewed[i].CurrentExpiryYear != int32(want.Expiry.Year()) || aws.ToInt32(renewed[i].DurationInYears) != 1 {
+			t.Errorf("Unexpected RenewDomain input %d: %+v", i, renewed[i])
+		}
+	}
+
+	var state AutoRenewalPolicyResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	wantRenewed := tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+		"expired.com": stringValue("op-expired.com"),
+		"soon.com":    stringValue("op-soon.com"),
+	})
+	if !state.RenewedDomains.Equal(wantRenewed) {
+		t.Errorf("Expected renewed_domains %s, got %s", wantRenewed, state.RenewedDomains)
+	}
+	if len(state.DueDomains.Elements()) != 0 {
+		t.Errorf("Expected no due domains after renewal, got %s", state.DueDomains)
+	}
+	if state.ID.ValueString() != autoRenewalPolicyID {
+		t.Errorf("Expected id %s, got %s", autoRenewalPolicyID, state.ID)
+	}
+}
+
+func TestAutoRenewalPolicy_renewalFailureKeepsDomainDue(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	var renewed []*route53domains.RenewDomainInput
+	r := &AutoRenewalPolicyResource{client: mockRenewalClient(testAccountDomains(time.Now()), &renewed, "expired.com")}
+
+	prior := testAutoRenewalPolicyModel()
+	prior.ID = stringValue(autoRenewalPolicyID)
+	prior.DueDomains = stringList([]string{"expired.com", "soon.com"})
+	prior.RenewedDomains = tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{"soon.com": stringValue("op-last-year")})
+	planned := *prior
+	planned.RenewedDomains = tftypes.MapUnknown(tftypes.StringType)
+	planned.DueDomains = tftypes.ListUnknown(tftypes.StringType)
+
+	state := newResourceState(t, r, prior)
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &planned), State: state}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "expired.com") {
+		t.Fatalf("Expected one renewal error for expired.com, got %v", resp.Diagnostics)
+	}
+
+	var got AutoRenewalPolicyResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	wantRenewed := tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{"soon.com": stringValue("op-soon.com")})
+	if !got.RenewedDomains.Equal(wantRenewed) {
+		t.Errorf("Expected renewed_domains %s, got %s", wantRenewed, got.RenewedDomains)
+	}
+	if !got.DueDomains.Equal(stringList([]string{"expired.com"})) {
+		t.Errorf("Expected expired.com to stay due, got %s", got.DueDomains)
+	}
+}
+
+func TestAutoRenewalPolicy_nothingDue(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now()
+	domains := []types.DomainSummary{
+		{DomainName: aws.String("later.com"), Expiry: aws.Time(now.AddDate(0, 0, 100)), AutoRenew: aws.Bool(false)},
+		{DomainName: aws.String("self-renewing.com"), Expiry: aws.Time(now.AddDate(0, 0, 5)), AutoRenew: aws.Bool(true)},
+	}
+	var renewed []*route53domains.RenewDomainInput
+	r := &AutoRenewalPolicyResource{client: mockRenewalClient(domains, &renewed)}
+
+	prior := testAutoRenewalPolicyModel()
+	prior.ID = stringValue(autoRenewalPolicyID)
+	prior.DueDomains = stringList([]string{"later.com"})
+	prior.RenewedDomains = tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{"soon.com": stringValue("op-soon.com")})
+	state := newResourceState(t, r, prior)
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+	var due tftypes.List
+	readResp.Diagnostics.Append(readResp.State.GetAttribute(ctx, path.Root("due_domains"), &due)...)
+	if len(due.Elements()) != 0 {
+		t.Errorf("Expected no due domains after refresh, got %s", due)
+	}
+
+	// A longer window planned against the refreshed state still finds
+	// nothing due, so renewed_domains is kept and nothing is renewed
+	planned := *prior
+	planned.WithinDays = tftypes.Int64Value(60)
+	plan := newResourcePlan(t, r, &planned)
+	planResp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: readResp.State, Plan: plan}, planResp)
+	if planResp.Diagnostics.HasError() || planResp.Diagnostics.WarningsCount() != 0 {
+		t.Fatalf("Expected a plan without diagnostics, got %v", planResp.Diagnostics)
+	}
+
+	resp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: planResp.Plan, State: readResp.State}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+	}
+	if len(renewed) != 0 {
+		t.Errorf("Expected no renewals, got %d", len(renewed))
+	}
+	var got AutoRenewalPolicyResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if !got.RenewedDomains.Equal(prior.RenewedDomains) {
+		t.Errorf("Expected renewed_domains to be kept, got %s", got.RenewedDomains)
+	}
+}
+
+func TestAutoRenewalPolicyValidateConfig(t *testing.T) {
+	r := &AutoRenewalPolicyResource{}
+
+	tests := []struct {
+		name       string
+		withinDays int64
+		years      int64
+		wantErrors int
+	}{
+		{"valid", 30, 1, 0},
+		{"zero days", 0, 1, 1},
+		{"too many years", 30, 11, 1},
+		{"both invalid", -1, 0, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := testAutoRenewalPolicyModel()
+			model.WithinDays = tftypes.Int64Value(tt.withinDays)
+			model.DurationYears = tftypes.Int64Value(tt.years)
+			plan := newResourcePlan(t, r, model)
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors {
+				t.Errorf("Expected %d errors, got %v", tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainAccountTransferResource{}
+
+type DomainAccountTransferResource struct {
+	client Route53DomainsAPI
+}
+
+type DomainAccountTransferResourceModel struct {
+	ID          tftypes.String `tfsdk:"id"`
+	DomainName  tftypes.String `tfsdk:"domain_name"`
+	AccountID   tftypes.String `tfsdk:"account_id"`
+	Password    tftypes.String `tfsdk:"password"`
+	OperationID tftypes.String `tfsdk:"operation_id"`
+	Status      tftypes.String `tfsdk:"status"`
+}
+
+func NewDomainAccountTransferResource() resource.Resource {
+	return &DomainAccountTransferResource{}
+}
+
+func (r *DomainAccountTransferResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_account_transfer"
+}
+
+func (r *DomainAccountTransferResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Initiates the transfer of a domain to another AWS account. The receiving account must accept the transfer using the generated password. Destroying this resource cancels the transfer if it is still pending.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The domain name (used as the resource ID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name to transfer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the AWS account to transfer the domain to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password the receiving account must provide to accept the transfer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the transfer operation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Current status of the transfer operation.",
+			},
+		},
+	}
+}
+
+func (r *DomainAccountTransferResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.DomainsClient
+}
+
+func (r *DomainAccountTransferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainAccountTransferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.DomainName.ValueString()
+	accountID := data.AccountID.ValueString()
+	tflog.Info(ctx, "Transferring domain to another AWS account", map[string]interface{}{
+		"domain":     domainName,
+		"account_id": accountID,
+	})
+
+	output, err := r.client.TransferDomainToAnotherAwsAccount(ctx, &route53domains.TransferDomainToAnotherAwsAccountInput{
+		DomainName: aws.String(domainName),
+		AccountId:  aws.String(accountID),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error transferring domain",
+			fmt.Sprintf("Could not transfer domain %s to account %s: %s", domainName, accountID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = tftypes.StringValue(domainName)
+	data.Password = tftypes.StringPointerValue(output.Password)
+	data.OperationID = tftypes.StringPointerValue(output.OperationId)
+	data.Status = tftypes.StringValue(string(types.OperationStatusSubmitted))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainAccountTransferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainAccountTransferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.OperationID.IsNull() {
+		return
+	}
+
+	opDetail, err := r.client.GetOperationDetail(ctx, &route53domains.GetOperationDetailInput{
+		OperationId: aws.String(data.OperationID.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading transfer status",
+			fmt.Sprintf("Could not read transfer operation %s for %s: %s", data.OperationID.ValueString(), data.DomainName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Status = tftypes.StringValue(string(opDetail.Status))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainAccountTransferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement; nothing to update in place.
+	var data DomainAccountTransferResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainAccountTransferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainAccountTransferResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.DomainName.ValueString()
+
+	status := types.OperationStatus(data.Status.ValueString())
+	if !data.OperationID.IsNull() {
+		opDetail, err := r.client.GetOperationDetail(ctx, &route53domains.GetOperationDetailInput{
+			OperationId: aws.String(data.OperationID.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading transfer status",
+				fmt.Sprintf("Could not read transfer operation %s for %s: %s", data.OperationID.ValueString(), domainName, err.Error()),
+			)
+			return
+		}
+		status = opDetail.Status
+	}
+
+	if status != types.OperationStatusSubmitted && status != types.OperationStatusInProgress {
+		tflog.Info(ctx, "Transfer is no longer pending, removing from state only", map[string]interface{}{
+			"domain": domainName,
+			"status": status,
+		})
+		return
+	}
+
+	tflog.Info(ctx, "Cancelling pending domain transfer", map[string]interface{}{
+		"domain": domainName,
+	})
+
+	_, err := r.client.CancelDomainTransferToAnotherAwsAccount(ctx, &route53domains.CancelDomainTransferToAnotherAwsAccountInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error cancelling domain transfer",
+			fmt.Sprintf("Could not cancel transfer of %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+}
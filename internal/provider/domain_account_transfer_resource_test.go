@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomainAccountTransferCreate(t *testing.T) {
+	ctx := context.Background()
+	var transferInput *route53domains.TransferDomainToAnotherAwsAccountInput
+	r := &DomainAccountTransferResource{
+		client: &MockRoute53DomainsClient{
+			TransferDomainToAnotherAwsAccountFunc: func(ctx context.Context, params *route53domains.TransferDomainToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.TransferDomainToAnotherAwsAccountOutput, error) {
+				transferInput = params
+				return &route53domains.TransferDomainToAnotherAwsAccountOutput{
+					OperationId: aws.String("op-transfer"),
+					Password:    aws.String("s3cret"),
+				}, nil
+			},
+		},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, &DomainAccountTransferResourceModel{
+		ID:          tftypes.StringUnknown(),
+		DomainName:  tftypes.StringValue("example.com"),
+		AccountID:   tftypes.StringValue("111122223333"),
+		Password:    tftypes.StringUnknown(),
+		OperationID: tftypes.StringUnknown(),
+		Status:      tftypes.StringUnknown(),
+	})}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if transferInput == nil || aws.ToString(transferInput.AccountId) != "111122223333" || aws.ToString(transferInput.DomainName) != "example.com" {
+		t.Fatalf("Unexpected transfer input: %+v", transferInput)
+	}
+
+	var state DomainAccountTransferResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.Password.ValueString() != "s3cret" {
+		t.Errorf("password mismatch: got %s", state.Password.ValueString())
+	}
+	if state.OperationID.ValueString() != "op-transfer" {
+		t.Errorf("operation_id mismatch: got %s", state.OperationID.ValueString())
+	}
+	if state.Status.ValueString() != "SUBMITTED" {
+		t.Errorf("status mismatch: got %s", state.Status.ValueString())
+	}
+}
+
+func TestDomainAccountTransferDelete(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       types.OperationStatus
+		expectCancel bool
+	}{
+		{"pending transfer is cancelled", types.OperationStatusInProgress, true},
+		{"completed transfer is left alone", types.OperationStatusSuccessful, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cancelled := false
+			r := &DomainAccountTransferResource{
+				client: &MockRoute53DomainsClient{
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						return &route53domains.GetOperationDetailOutput{Status: tt.status}, nil
+					},
+					CancelDomainTransferToAnotherAwsAccountFunc: func(ctx context.Context, params *route53domains.CancelDomainTransferToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.CancelDomainTransferToAnotherAwsAccountOutput, error) {
+						cancelled = aws.ToString(params.DomainName) == "example.com"
+						return &route53domains.CancelDomainTransferToAnotherAwsAccountOutput{}, nil
+					},
+				},
+			}
+
+			state := newResourceState(t, r, &DomainAccountTransferResourceModel{
+				ID:          tftypes.StringValue("example.com"),
+				DomainName:  tftypes.StringValue("example.com"),
+				AccountID:   tftypes.StringValue("111122223333"),
+				Password:    tftypes.StringValue("s3cret"),
+				OperationID: tftypes.StringValue("op-transfer"),
+				Status:      tftypes.StringValue("SUBMITTED"),
+			})
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Delete returned errors: %v", resp.Diagnostics)
+			}
+			if cancelled != tt.expectCancel {
+				t.Errorf("Expected cancel=%v, got %v", tt.expectCancel, cancelled)
+			}
+		})
+	}
+}
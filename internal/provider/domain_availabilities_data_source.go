@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainAvailabilitiesDataSource{}
+
+// availabilityWorkers bounds the number of concurrent CheckDomainAvailability
+// calls made for a single batch.
+const availabilityWorkers = 5
+
+type DomainAvailabilitiesDataSource struct {
+	client Route53DomainsAPI
+}
+
+type DomainAvailabilitiesDataSourceModel struct {
+	ID          types.String                    `tfsdk:"id"`
+	DomainNames []types.String                  `tfsdk:"domain_names"`
+	Results     []DomainAvailabilityResultModel `tfsdk:"results"`
+}
+
+type DomainAvailabilityResultModel struct {
+	DomainName   types.String `tfsdk:"domain_name"`
+	Availability types.String `tfsdk:"availability"`
+	Available    types.Bool   `tfsdk:"available"`
+	Error        types.String `tfsdk:"error"`
+}
+
+func NewDomainAvailabilitiesDataSource() datasource.DataSource {
+	return &DomainAvailabilitiesDataSource{}
+}
+
+func (d *DomainAvailabilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_availabilities"
+}
+
+func (d *DomainAvailabilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Check whether multiple domain names are available for registration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Comma-separated list of the checked domain names.",
+			},
+			"domain_names": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The domain names to check availability for.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Availability results, in the same order as domain_names.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The domain name.",
+						},
+						"availability": schema.StringAttribute{
+							Computed:    true,
+							Description: "The availability status: AVAILABLE, AVAILABLE_RESERVED, AVAILABLE_PREORDER, UNAVAILABLE, UNAVAILABLE_PREMIUM, UNAVAILABLE_RESTRICTED, RESERVED, DONT_KNOW. Null if the check failed.",
+						},
+						"available": schema.BoolAttribute{
+							Computed:    true,
+							Description: "True if the domain is available for registration.",
+						},
+						"error": schema.StringAttribute{
+							Computed:    true,
+							Description: "Error returned when checking this domain, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainAvailabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.DomainsClient
+}
+
+func (d *DomainAvailabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainAvailabilitiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainNames := make([]string, len(data.DomainNames))
+	for i, name := range data.DomainNames {
+		domainNames[i] = name.ValueString()
+	}
+
+	results := make([]DomainAvailabilityResultModel, len(domainNames))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < availabilityWorkers && w < len(domainNames); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = d.checkDomain(ctx, domainNames[i])
+			}
+		}()
+	}
+	for i := range domainNames {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var failed []string
+	for _, result := range results {
+		if !result.Error.IsNull() {
+			failed = append(failed, fmt.Sprintf("%s: %s", result.DomainName.ValueString(), result.Error.ValueString()))
+		}
+	}
+	if len(failed) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Some domain availability checks failed",
+			fmt.Sprintf("Could not check availability for %d of %d domains:\n%s", len(failed), len(domainNames), strings.Join(failed, "\n")),
+		)
+	}
+
+	data.ID = types.StringValue(strings.Join(domainNames, ","))
+	data.Results = results
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *DomainAvailabilitiesDataSource) checkDomain(ctx context.Context, domainName string) DomainAvailabilityResultModel {
+	result := DomainAvailabilityResultModel{
+		DomainName:   types.StringValue(domainName),
+		Availability: types.StringNull(),
+		Available:    types.BoolValue(false),
+		Error:        types.StringNull(),
+	}
+
+	output, err := d.client.CheckDomainAvailability(ctx, &route53domains.CheckDomainAvailabilityInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		result.Error = types.StringValue(err.Error())
+		return result
+	}
+
+	result.Availability = types.StringValue(string(output.Availability))
+	result.Available = types.BoolValue(domainAvailable(output.Availability))
+	return result
+}
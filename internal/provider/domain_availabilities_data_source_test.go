@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomainAvailabilitiesDataSourceRead_mixedResults(t *testing.T) {
+	ctx := context.Background()
+	responses := map[string]types.DomainAvailability{
+		"free.com":    types.DomainAvailabilityAvailable,
+		"taken.com":   types.DomainAvailabilityUnavailable,
+		"premium.com": types.DomainAvailabilityUnavailablePremium,
+		"unsure.com":  types.DomainAvailabilityDontKnow,
+	}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	d := &DomainAvailabilitiesDataSource{
+		client: &MockRoute53DomainsClient{
+			CheckDomainAvailabilityFunc: func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error) {
+				mu.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mu.Unlock()
+				defer func() {
+					mu.Lock()
+					inFlight--
+					mu.Unlock()
+				}()
+				time.Sleep(5 * time.Millisecond)
+
+				name := aws.ToString(params.DomainName)
+				availability, ok := responses[name]
+				if !ok {
+					return nil, errors.New("ThrottlingException: rate exceeded")
+				}
+				return &route53domains.CheckDomainAvailabilityOutput{Availability: availability}, nil
+			},
+		},
+	}
+
+	names := []string{"free.com", "taken.com", "broken.com", "premium.com", "unsure.com", "free.com", "taken.com"}
+	var domainNames []tftypes.String
+	for _, name := range names {
+		domainNames = append(domainNames, tftypes.StringValue(name))
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilitiesDataSourceModel{
+		DomainNames: domainNames,
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning for the failed domain, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	var state DomainAvailabilitiesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if len(state.Results) != len(names) {
+		t.Fatalf("Expected %d results, got %d", len(names), len(state.Results))
+	}
+
+	expected := []struct {
+		availability string
+		available    bool
+		failed       bool
+	}{
+		{"AVAILABLE", true, false},
+		{"UNAVAILABLE", false, false},
+		{"", false, true},
+		{"UNAVAILABLE_PREMIUM", false, false},
+		{"DONT_KNOW", false, false},
+		{"AVAILABLE", true, false},
+		{"UNAVAILABLE", false, false},
+	}
+	for i, want := range expected {
+		got := state.Results[i]
+		if got.DomainName.ValueString() != names[i] {
+			t.Errorf("result %d: domain_name got %s, want %s", i, got.DomainName.ValueString(), names[i])
+		}
+		if got.Availability.ValueString() != want.availability {
+			t.Errorf("result %d: availability got %s, want %s", i, got.Availability.ValueString(), want.availability)
+		}
+		if got.Available.ValueBool() != want.available {
+			t.Errorf("result %d: available got %v, want %v", i, got.Available.ValueBool(), want.available)
+		}
+		if got.Error.IsNull() == want.failed {
+			t.Errorf("result %d: error got %q, want failed=%v", i, got.Error.ValueString(), want.failed)
+		}
+	}
+
+	if maxInFlight > availabilityWorkers {
+		t.Errorf("Expected at most %d concurrent checks, got %d", availabilityWorkers, maxInFlight)
+	}
+}
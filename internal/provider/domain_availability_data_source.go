@@ -2,26 +2,54 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// defaultDontKnowRetryDelay is the wait between DONT_KNOW retries when
+// dont_know_retry_delay is not set.
+const defaultDontKnowRetryDelay = 5 * time.Second
+
+// defaultAvailabilityWaitTimeout bounds wait_until_available when
+// wait_timeout is not set.
+const defaultAvailabilityWaitTimeout = 10 * time.Minute
+
+// availabilityPollInterval is how often CheckDomainAvailability is polled
+// while waiting for a domain to become available. Tests shorten it.
+var availabilityPollInterval = 30 * time.Second
+
 var _ datasource.DataSource = &DomainAvailabilityDataSource{}
 
 type DomainAvailabilityDataSource struct {
-	client *route53domains.Client
+	client Route53DomainsAPI
 }
 
 type DomainAvailabilityDataSourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	DomainName   types.String `tfsdk:"domain_name"`
-	Availability types.String `tfsdk:"availability"`
-	Available    types.Bool   `tfsdk:"available"`
+	ID                 types.String `tfsdk:"id"`
+	DomainName         types.String `tfsdk:"domain_name"`
+	IdnLangCode        types.String `tfsdk:"idn_lang_code"`
+	Availability       types.String `tfsdk:"availability"`
+	Available          types.Bool   `tfsdk:"available"`
+	Registrable        types.Bool   `tfsdk:"registrable"`
+	Premium            types.Bool   `tfsdk:"premium"`
+	Reserved           types.Bool   `tfsdk:"reserved"`
+	Restricted         types.Bool   `tfsdk:"restricted"`
+	Preorder           types.Bool   `tfsdk:"preorder"`
+	DontKnowRetries    types.Int64  `tfsdk:"dont_know_retries"`
+	DontKnowRetryDelay types.Int64  `tfsdk:"dont_know_retry_delay"`
+	WaitUntilAvailable types.Bool   `tfsdk:"wait_until_available"`
+	WaitTimeout        types.Int64  `tfsdk:"wait_timeout"`
 }
 
 func NewDomainAvailabilityDataSource() datasource.DataSource {
@@ -44,13 +72,53 @@ func (d *DomainAvailabilityDataSource) Schema(ctx context.Context, req datasourc
 				Required:    true,
 				Description: "The domain name to check availability for.",
 			},
+			"idn_lang_code": schema.StringAttribute{
+				Optional:    true,
+				Description: "Language code of an internationalized domain name, passed to CheckDomainAvailability as IdnLangCode, for TLDs whose registries check IDN availability per language.",
+			},
 			"availability": schema.StringAttribute{
 				Computed:    true,
 				Description: "The availability status: AVAILABLE, AVAILABLE_RESERVED, AVAILABLE_PREORDER, UNAVAILABLE, UNAVAILABLE_PREMIUM, UNAVAILABLE_RESTRICTED, RESERVED, DONT_KNOW.",
 			},
 			"available": schema.BoolAttribute{
 				Computed:    true,
-				Description: "True if the domain is available for registration.",
+				Description: "True if the domain is available for registration: AVAILABLE, AVAILABLE_RESERVED or AVAILABLE_PREORDER.",
+			},
+			"registrable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if a RegisterDomain call for the domain could succeed now: AVAILABLE, or AVAILABLE_RESERVED subject to the registry's conditions. False for AVAILABLE_PREORDER, which can only be preordered, and for DONT_KNOW.",
+			},
+			"premium": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the domain is a premium name (UNAVAILABLE_PREMIUM). Route 53 does not register premium names, so registrable is false for them.",
+			},
+			"reserved": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the registry has reserved the domain: AVAILABLE_RESERVED, which can be registered on the registry's conditions, or RESERVED, which cannot be registered.",
+			},
+			"restricted": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the registry restricts who can register the domain and the account does not qualify (UNAVAILABLE_RESTRICTED).",
+			},
+			"preorder": schema.BoolAttribute{
+				Computed:    true,
+				Description: "True if the domain can be preordered but not registered yet (AVAILABLE_PREORDER).",
+			},
+			"dont_know_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of times to retry the check when AWS returns DONT_KNOW (default: 0). If every attempt returns DONT_KNOW, that status is returned as-is. A throttled attempt counts as a retry.",
+			},
+			"dont_know_retry_delay": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Seconds to wait between DONT_KNOW retries (default: 5).",
+			},
+			"wait_until_available": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Poll the check every 30 seconds until the domain is available, for a domain about to drop (default: false). If it is still unavailable after wait_timeout, the last status is returned with a warning.",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Seconds to wait for the domain to become available when wait_until_available is true (default: 600).",
 			},
 		},
 	}
@@ -82,10 +150,53 @@ func (d *DomainAvailabilityDataSource) Read(ctx context.Context, req datasource.
 	}
 
 	domainName := data.DomainName.ValueString()
+	input := &route53domains.CheckDomainAvailabilityInput{
+		DomainName:  aws.String(domainName),
+		IdnLangCode: data.IdnLangCode.ValueStringPointer(),
+	}
+
+	retries := data.DontKnowRetries.ValueInt64()
+	delay := defaultDontKnowRetryDelay
+	if !data.DontKnowRetryDelay.IsNull() {
+		delay = time.Duration(data.DontKnowRetryDelay.ValueInt64()) * time.Second
+	}
 
-	output, err := d.client.CheckDomainAvailability(ctx, &route53domains.CheckDomainAvailabilityInput{
-		DomainName: aws.String(domainName),
-	})
+	var output *route53domains.CheckDomainAvailabilityOutput
+	var err error
+	if data.WaitUntilAvailable.ValueBool() {
+		timeout := defaultAvailabilityWaitTimeout
+		if !data.WaitTimeout.IsNull() {
+			timeout = time.Duration(data.WaitTimeout.ValueInt64()) * time.Second
+		}
+		output, err = Waiter[*route53domains.CheckDomainAvailabilityOutput]{
+			InitialDelay: availabilityPollInterval,
+			Jitter:       0.2,
+			Timeout:      timeout,
+			Poll: func(ctx context.Context) (*route53domains.CheckDomainAvailabilityOutput, error) {
+				output, err := d.checkAvailability(ctx, input, retries, delay)
+				if err == nil {
+					tflog.Debug(ctx, "Waiting for domain to become available", map[string]interface{}{
+						"domain":       domainName,
+						"availability": output.Availability,
+					})
+				}
+				return output, err
+			},
+			Terminal: func(output *route53domains.CheckDomainAvailabilityOutput) bool {
+				return domainAvailable(output.Availability)
+			},
+		}.Wait(ctx)
+		if errors.Is(err, errWaitTimeout) && output != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("wait_timeout"),
+				"Domain not available yet",
+				fmt.Sprintf("%s was still %s after waiting %s; returning that status.", domainName, output.Availability, timeout),
+			)
+			err = nil
+		}
+	} else {
+		output, err = d.checkAvailability(ctx, input, retries, delay)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error checking domain availability",
@@ -96,7 +207,79 @@ func (d *DomainAvailabilityDataSource) Read(ctx context.Context, req datasource.
 
 	data.ID = types.StringValue(domainName)
 	data.Availability = types.StringValue(string(output.Availability))
-	data.Available = types.BoolValue(output.Availability == "AVAILABLE" || output.Availability == "AVAILABLE_RESERVED" || output.Availability == "AVAILABLE_PREORDER")
+	data.Available = types.BoolValue(domainAvailable(output.Availability))
+	data.Registrable = types.BoolValue(domainRegistrable(output.Availability))
+	data.Premium = types.BoolValue(output.Availability == awstypes.DomainAvailabilityUnavailablePremium)
+	data.Reserved = types.BoolValue(domainReserved(output.Availability))
+	data.Restricted = types.BoolValue(output.Availability == awstypes.DomainAvailabilityUnavailableRestricted)
+	data.Preorder = types.BoolValue(output.Availability == awstypes.DomainAvailabilityAvailablePreorder)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// checkAvailability calls CheckDomainAvailability with input, retrying up to
+// retries times, delay apart, while AWS answers DONT_KNOW. The last answer is
+// returned once retries are used up.
+func (d *DomainAvailabilityDataSource) checkAvailability(ctx context.Context, input *route53domains.CheckDomainAvailabilityInput, retries int64, delay time.Duration) (*route53domains.CheckDomainAvailabilityOutput, error) {
+	var pollErr error
+	attempt := 0
+	output, err := Waiter[*route53domains.CheckDomainAvailabilityOutput]{
+		InitialDelay: delay,
+		// The retry count, not a timeout, bounds the wait
+		Timeout:     math.MaxInt64,
+		MaxAttempts: int(retries) + 1,
+		Poll: func(ctx context.Context) (*route53domains.CheckDomainAvailabilityOutput, error) {
+			attempt++
+			output, err := d.client.CheckDomainAvailability(ctx, input)
+			pollErr = err
+			if err == nil && output.Availability == awstypes.DomainAvailabilityDontKnow && int64(attempt) <= retries {
+				tflog.Debug(ctx, "Domain availability unknown, retrying", map[string]interface{}{
+					"domain":  aws.ToString(input.DomainName),
+					"attempt": attempt,
+					"retries": retries,
+				})
+			}
+			return output, err
+		},
+		Terminal: func(output *route53domains.CheckDomainAvailabilityOutput) bool {
+			return output.Availability != awstypes.DomainAvailabilityDontKnow
+		},
+	}.Wait(ctx)
+	switch {
+	case errors.Is(err, errWaitAttemptsExhausted):
+		if output == nil {
+			// Every attempt was throttled
+			return nil, pollErr
+		}
+		return output, nil
+	case ctx.Err() != nil:
+		return nil, fmt.Errorf("interrupted while retrying: %w", ctx.Err())
+	case err != nil:
+		return nil, err
+	}
+	return output, nil
+}
+
+// domainAvailable reports whether an availability status means the domain can
+// be registered.
+func domainAvailable(availability awstypes.DomainAvailability) bool {
+	return availability == awstypes.DomainAvailabilityAvailable ||
+		availability == awstypes.DomainAvailabilityAvailableReserved ||
+		availability == awstypes.DomainAvailabilityAvailablePreorder
+}
+
+// domainRegistrable reports whether RegisterDomain could succeed for a domain
+// with this availability status. It is narrower than domainAvailable: a
+// preorder cannot be registered yet, and a reserved name only when the
+// registry's conditions are met.
+func domainRegistrable(availability awstypes.DomainAvailability) bool {
+	return availability == awstypes.DomainAvailabilityAvailable ||
+		availability == awstypes.DomainAvailabilityAvailableReserved
+}
+
+// domainReserved reports whether an availability status means the registry
+// has reserved the domain, whether or not it can still be registered.
+func domainReserved(availability awstypes.DomainAvailability) bool {
+	return availability == awstypes.DomainAvailabilityAvailableReserved ||
+		availability == awstypes.DomainAvailabilityReserved
+}
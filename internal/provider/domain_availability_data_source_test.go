@@ -1,8 +1,18 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -40,6 +50,414 @@ func TestAccDomainAvailabilityDataSource_availableDomain(t *testing.T) {
 	})
 }
 
+// mockAvailabilitySequence returns a client whose CheckDomainAvailability
+// returns the given statuses in order, repeating the last one.
+func mockAvailabilitySequence(calls *int, statuses ...types.DomainAvailability) *MockRoute53DomainsClient {
+	return &MockRoute53DomainsClient{
+		CheckDomainAvailabilityFunc: func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error) {
+			i := *calls
+			if i >= len(statuses) {
+				i = len(statuses) - 1
+			}
+			*calls++
+			return &route53domains.CheckDomainAvailabilityOutput{Availability: statuses[i]}, nil
+		},
+	}
+}
+
+func TestDomainAvailabilityDataSourceConfigure(t *testing.T) {
+	client := &MockRoute53DomainsClient{}
+	d := &DomainAvailabilityDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: &ProviderData{DomainsClient: client},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+	if d.client != client {
+		t.Errorf("Expected the DomainsClient from ProviderData, got %v", d.client)
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_retriesDontKnow(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	d := &DomainAvailabilityDataSource{
+		client: mockAvailabilitySequence(&calls,
+			types.DomainAvailabilityDontKnow,
+			types.DomainAvailabilityDontKnow,
+			types.DomainAvailabilityAvailable,
+		),
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+		DomainName:         tftypes.StringValue("example.com"),
+		DontKnowRetries:    tftypes.Int64Value(3),
+		DontKnowRetryDelay: tftypes.Int64Value(0),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var state DomainAvailabilityDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.Availability.ValueString() != "AVAILABLE" || !state.Available.ValueBool() {
+		t.Errorf("Expected AVAILABLE after retries, got %s", state.Availability.ValueString())
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_dontKnowAfterRetriesExhausted(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	d := &DomainAvailabilityDataSource{
+		client: mockAvailabilitySequence(&calls, types.DomainAvailabilityDontKnow),
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+		DomainName:         tftypes.StringValue("example.com"),
+		DontKnowRetries:    tftypes.Int64Value(2),
+		DontKnowRetryDelay: tftypes.Int64Value(0),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var state DomainAvailabilityDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.Availability.ValueString() != "DONT_KNOW" || state.Available.ValueBool() {
+		t.Errorf("Expected DONT_KNOW, got %s", state.Availability.ValueString())
+	}
+	if calls != 3 {
+		t.Errorf("Expected 1 attempt plus 2 retries, got %d calls", calls)
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_dontKnowRetriesTolerateThrottling(t *testing.T) {
+	tests := []struct {
+		name      string
+		retries   int64
+		wantErr   bool
+		wantCalls int
+	}{
+		{"throttled then available", 1, false, 2},
+		{"throttled without retries", 0, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			calls := 0
+			d := &DomainAvailabilityDataSource{
+				client: &MockRoute53DomainsClient{
+					CheckDomainAvailabilityFunc: func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error) {
+						calls++
+						if calls == 1 {
+							return nil, &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+						}
+						return &route53domains.CheckDomainAvailabilityOutput{Availability: types.DomainAvailabilityAvailable}, nil
+					},
+				},
+			}
+
+			req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+				DomainName:         tftypes.StringValue("example.com"),
+				DontKnowRetries:    tftypes.Int64Value(tt.retries),
+				DontKnowRetryDelay: tftypes.Int64Value(0),
+			})
+			d.Read(ctx, req, resp)
+
+			if calls != tt.wantCalls {
+				t.Errorf("Expected %d calls, got %d", tt.wantCalls, calls)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("Expected error %t, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if !tt.wantErr {
+				var state DomainAvailabilityDataSourceModel
+				resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+				if !state.Available.ValueBool() {
+					t.Errorf("Expected AVAILABLE after the throttled attempt, got %s", state.Availability)
+				}
+			}
+		})
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_throttling(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxRetries   int64
+		wantRequests int
+		wantErr      bool
+	}{
+		{"retried", 3, 2, false},
+		{"max_retries = 0", 0, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests == 1 {
+					writeFakeError(w, "ThrottlingException", "Rate exceeded")
+					return
+				}
+				w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+				_, _ = w.Write([]byte(`{"Availability":"AVAILABLE"}`))
+			}))
+			defer server.Close()
+
+			providerData := configureTestProvider(t, &AWSDomainsProviderModel{
+				Route53DomainsEndpoint: tftypes.StringValue(server.URL),
+				MaxRetries:             tftypes.Int64Value(tt.maxRetries),
+			})
+			ctx := context.Background()
+			d := &DomainAvailabilityDataSource{}
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: providerData}, &datasource.ConfigureResponse{})
+
+			req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+				DomainName: tftypes.StringValue("example.com"),
+			})
+			d.Read(ctx, req, resp)
+
+			if requests != tt.wantRequests {
+				t.Errorf("Expected %d requests, got %d", tt.wantRequests, requests)
+			}
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("Expected the throttling error without retries")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+			var state DomainAvailabilityDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if !state.Available.ValueBool() {
+				t.Errorf("Expected available = true after a throttled attempt, got %s", state.Availability)
+			}
+		})
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_idnLangCode(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		langCode tftypes.String
+		want     *string
+	}{
+		{"forwarded", tftypes.StringValue("DE"), aws.String("DE")},
+		{"omitted when unset", tftypes.StringNull(), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var input *route53domains.CheckDomainAvailabilityInput
+			d := &DomainAvailabilityDataSource{
+				client: &MockRoute53DomainsClient{
+					CheckDomainAvailabilityFunc: func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error) {
+						input = params
+						return &route53domains.CheckDomainAvailabilityOutput{Availability: types.DomainAvailabilityAvailable}, nil
+					},
+				},
+			}
+
+			req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+				DomainName:  tftypes.StringValue("xn--mnchen-3ya.de"),
+				IdnLangCode: tt.langCode,
+			})
+			d.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			if aws.ToString(input.DomainName) != "xn--mnchen-3ya.de" {
+				t.Errorf("Expected domain xn--mnchen-3ya.de, got %s", aws.ToString(input.DomainName))
+			}
+			if (input.IdnLangCode == nil) != (tt.want == nil) || aws.ToString(input.IdnLangCode) != aws.ToString(tt.want) {
+				t.Errorf("Expected IdnLangCode %v, got %v", aws.ToString(tt.want), aws.ToString(input.IdnLangCode))
+			}
+		})
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_noRetryByDefault(t *testing.T) {
+	calls := 0
+	d := &DomainAvailabilityDataSource{
+		client: mockAvailabilitySequence(&calls, types.DomainAvailabilityDontKnow, types.DomainAvailabilityAvailable),
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+		DomainName: tftypes.StringValue("example.com"),
+	})
+	d.Read(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+	if calls != 1 {
+		t.Errorf("Expected a single call without dont_know_retries, got %d", calls)
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_waitUntilAvailable(t *testing.T) {
+	defer func(interval time.Duration) { availabilityPollInterval = interval }(availabilityPollInterval)
+	availabilityPollInterval = time.Millisecond
+
+	ctx := context.Background()
+	calls := 0
+	d := &DomainAvailabilityDataSource{
+		client: mockAvailabilitySequence(&calls,
+			types.DomainAvailabilityUnavailable,
+			types.DomainAvailabilityUnavailable,
+			types.DomainAvailabilityAvailable,
+		),
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+		DomainName:         tftypes.StringValue("example.com"),
+		WaitUntilAvailable: tftypes.BoolValue(true),
+		WaitTimeout:        tftypes.Int64Value(60),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state DomainAvailabilityDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.Availability.ValueString() != "AVAILABLE" || !state.Available.ValueBool() {
+		t.Errorf("Expected AVAILABLE after waiting, got %s", state.Availability.ValueString())
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_waitTimeoutReturnsLastStatus(t *testing.T) {
+	defer func(interval time.Duration) { availabilityPollInterval = interval }(availabilityPollInterval)
+	availabilityPollInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	calls := 0
+	d := &DomainAvailabilityDataSource{
+		client: mockAvailabilitySequence(&calls, types.DomainAvailabilityUnavailable),
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+		DomainName:         tftypes.StringValue("example.com"),
+		WaitUntilAvailable: tftypes.BoolValue(true),
+		WaitTimeout:        tftypes.Int64Value(0),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected a timeout warning, got %v", resp.Diagnostics)
+	}
+
+	var state DomainAvailabilityDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.Availability.ValueString() != "UNAVAILABLE" || state.Available.ValueBool() {
+		t.Errorf("Expected UNAVAILABLE, got %s", state.Availability.ValueString())
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_waitCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &DomainAvailabilityDataSource{
+		client: &MockRoute53DomainsClient{
+			CheckDomainAvailabilityFunc: func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error) {
+				cancel()
+				return &route53domains.CheckDomainAvailabilityOutput{Availability: types.DomainAvailabilityUnavailable}, nil
+			},
+		},
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+		DomainName:         tftypes.StringValue("example.com"),
+		WaitUntilAvailable: tftypes.BoolValue(true),
+	})
+	done := make(chan struct{})
+	go func() {
+		d.Read(ctx, req, resp)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Read did not stop after cancellation")
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error after cancellation")
+	}
+}
+
+func TestDomainAvailabilityDataSourceRead_statusMatrix(t *testing.T) {
+	tests := []struct {
+		availability types.DomainAvailability
+		available    bool
+		registrable  bool
+		premium      bool
+		reserved     bool
+		restricted   bool
+		preorder     bool
+	}{
+		{types.DomainAvailabilityAvailable, true, true, false, false, false, false},
+		{types.DomainAvailabilityAvailableReserved, true, true, false, true, false, false},
+		{types.DomainAvailabilityAvailablePreorder, true, false, false, false, false, true},
+		{types.DomainAvailabilityUnavailable, false, false, false, false, false, false},
+		{types.DomainAvailabilityUnavailablePremium, false, false, true, false, false, false},
+		{types.DomainAvailabilityUnavailableRestricted, false, false, false, false, true, false},
+		{types.DomainAvailabilityReserved, false, false, false, true, false, false},
+		{types.DomainAvailabilityDontKnow, false, false, false, false, false, false},
+		{types.DomainAvailabilityPending, false, false, false, false, false, false},
+		{types.DomainAvailabilityInvalidNameForTld, false, false, false, false, false, false},
+	}
+
+	if got, want := len(tests), len(types.DomainAvailability("").Values()); got != want {
+		t.Fatalf("Matrix covers %d statuses, the SDK defines %d", got, want)
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.availability), func(t *testing.T) {
+			ctx := context.Background()
+			calls := 0
+			d := &DomainAvailabilityDataSource{
+				client: mockAvailabilitySequence(&calls, tt.availability),
+			}
+
+			req, resp := newDataSourceReadRequest(t, d, &DomainAvailabilityDataSourceModel{
+				DomainName: tftypes.StringValue("example.com"),
+			})
+			d.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var state DomainAvailabilityDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.Available.ValueBool() != tt.available || state.Registrable.ValueBool() != tt.registrable || state.Premium.ValueBool() != tt.premium {
+				t.Errorf("Expected available=%v registrable=%v premium=%v, got %s %s %s",
+					tt.available, tt.registrable, tt.premium, state.Available, state.Registrable, state.Premium)
+			}
+			if state.Reserved.ValueBool() != tt.reserved || state.Restricted.ValueBool() != tt.restricted || state.Preorder.ValueBool() != tt.preorder {
+				t.Errorf("Expected reserved=%v restricted=%v preorder=%v, got %s %s %s",
+					tt.reserved, tt.restricted, tt.preorder, state.Reserved, state.Restricted, state.Preorder)
+			}
+		})
+	}
+}
+
 func testAccDomainAvailabilityDataSourceConfig(domain string) string {
 	return `
 provider "awsdomains" {
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainContactsDataSource{}
+
+type DomainContactsDataSource struct {
+	client Route53DomainsAPI
+}
+
+type DomainContactsDataSourceModel struct {
+	ID                types.String  `tfsdk:"id"`
+	DomainName        types.String  `tfsdk:"domain_name"`
+	AdminContact      *ContactModel `tfsdk:"admin_contact"`
+	RegistrantContact *ContactModel `tfsdk:"registrant_contact"`
+	TechContact       *ContactModel `tfsdk:"tech_contact"`
+	BillingContact    *ContactModel `tfsdk:"billing_contact"`
+	AdminPrivacy      types.Bool    `tfsdk:"admin_privacy"`
+	RegistrantPrivacy types.Bool    `tfsdk:"registrant_privacy"`
+	TechPrivacy       types.Bool    `tfsdk:"tech_privacy"`
+	BillingPrivacy    types.Bool    `tfsdk:"billing_privacy"`
+}
+
+func NewDomainContactsDataSource() datasource.DataSource {
+	return &DomainContactsDataSource{}
+}
+
+func (d *DomainContactsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_contacts"
+}
+
+// contactDataSourceSchema is the read-only counterpart of contactSchema.
+func contactDataSourceSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"first_name":        schema.StringAttribute{Computed: true, Description: "First name of the contact."},
+			"last_name":         schema.StringAttribute{Computed: true, Description: "Last name of the contact."},
+			"organization_name": schema.StringAttribute{Computed: true, Description: "Name of the organization."},
+			"email":             schema.StringAttribute{Computed: true, Description: "Email address of the contact."},
+			"phone_number":      schema.StringAttribute{Computed: true, Description: "Phone number in E.164 format."},
+			"address_line_1":    schema.StringAttribute{Computed: true, Description: "First line of the street address."},
+			"address_line_2":    schema.StringAttribute{Computed: true, Description: "Second line of the street address."},
+			"city":              schema.StringAttribute{Computed: true, Description: "City name."},
+			"state":             schema.StringAttribute{Computed: true, Description: "State or province."},
+			"zip_code":          schema.StringAttribute{Computed: true, Description: "Postal/ZIP code."},
+			"country_code":      schema.StringAttribute{Computed: true, Description: "Two-letter country code."},
+			"contact_type":      schema.StringAttribute{Computed: true, Description: "Contact type: PERSON, COMPANY, ASSOCIATION, PUBLIC_BODY, or RESELLER."},
+			"extra_params":      schema.MapAttribute{Computed: true, ElementType: types.StringType, Description: "Additional TLD-specific values, keyed by AWS ExtraParam name."},
+		},
+	}
+}
+
+func (d *DomainContactsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Read the current contacts of a domain registered in this account. Contacts with privacy protection enabled are returned as AWS reports them, which may be redacted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The domain name.",
+			},
+			"domain_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name to read contacts for.",
+			},
+			"admin_contact":      contactDataSourceSchema("Administrative contact."),
+			"registrant_contact": contactDataSourceSchema("Registrant contact."),
+			"tech_contact":       contactDataSourceSchema("Technical contact."),
+			"billing_contact":    contactDataSourceSchema("Billing contact, if one is set."),
+			"admin_privacy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether privacy protection is enabled for the admin contact.",
+			},
+			"registrant_privacy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether privacy protection is enabled for the registrant contact.",
+			},
+			"tech_privacy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether privacy protection is enabled for the tech contact.",
+			},
+			"billing_privacy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether privacy protection is enabled for the billing contact.",
+			},
+		},
+	}
+}
+
+func (d *DomainContactsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.DomainsClient
+}
+
+func (d *DomainContactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainContactsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.DomainName.ValueString()
+
+	output, err := d.client.GetDomainDetail(ctx, &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain contacts",
+			fmt.Sprintf("Could not read contacts for %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(domainName)
+	data.AdminContact = contactDetailModel(output.AdminContact)
+	data.RegistrantContact = contactDetailModel(output.RegistrantContact)
+	data.TechContact = contactDetailModel(output.TechContact)
+	data.BillingContact = contactDetailModel(output.BillingContact)
+	data.AdminPrivacy = types.BoolPointerValue(output.AdminPrivacy)
+	data.RegistrantPrivacy = types.BoolPointerValue(output.RegistrantPrivacy)
+	data.TechPrivacy = types.BoolPointerValue(output.TechPrivacy)
+	data.BillingPrivacy = types.BoolPointerValue(output.BillingPrivacy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// contactDetailModel converts a contact as AWS reports it, including its
+// contact_type and extra params, without the drift handling the resource
+// applies.
+func contactDetailModel(c *awstypes.ContactDetail) *ContactModel {
+	m := contactFromAWS(nil, c, false)
+	if m != nil {
+		if c.ContactType != "" {
+			m.ContactType = types.StringValue(string(c.ContactType))
+		}
+		if len(c.ExtraParams) > 0 {
+			m.ExtraParams = extraParamsFromAWS(c.ExtraParams)
+		}
+	}
+	return m
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomainContactsDataSourceMetadata(t *testing.T) {
+	d := NewDomainContactsDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "awsdomains"}, resp)
+
+	if resp.TypeName != "awsdomains_domain_contacts" {
+		t.Errorf("Expected TypeName 'awsdomains_domain_contacts', got '%s'", resp.TypeName)
+	}
+}
+
+func TestDomainContactsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	var requested string
+	d := &DomainContactsDataSource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				requested = aws.ToString(params.DomainName)
+				return &route53domains.GetDomainDetailOutput{
+					DomainName: params.DomainName,
+					AdminContact: &types.ContactDetail{
+						FirstName:   aws.String("John"),
+						LastName:    aws.String("Doe"),
+						Email:       aws.String("admin@example.com"),
+						CountryCode: types.CountryCodeUs,
+						ContactType: types.ContactTypePerson,
+					},
+					RegistrantContact: &types.ContactDetail{
+						FirstName:        aws.String("Jane"),
+						LastName:         aws.String("Doe"),
+						Email:            aws.String("registrant@example.com"),
+						OrganizationName: aws.String("Example Inc"),
+						ContactType:      types.ContactTypeCompany,
+					},
+					TechContact:       &types.ContactDetail{Email: aws.String("redacted@example.com")},
+					AdminPrivacy:      aws.Bool(false),
+					RegistrantPrivacy: aws.Bool(false),
+					TechPrivacy:       aws.Bool(true),
+				}, nil
+			},
+		},
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainContactsDataSourceModel{
+		DomainName: tftypes.StringValue("example.com"),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	if requested != "example.com" {
+		t.Errorf("Expected example.com to be requested, got '%s'", requested)
+	}
+
+	var state DomainContactsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Could not read state: %v", resp.Diagnostics)
+	}
+
+	if state.ID.ValueString() != "example.com" {
+		t.Errorf("Expected id example.com, got %s", state.ID)
+	}
+	if state.AdminContact == nil || state.AdminContact.Email.ValueString() != "admin@example.com" {
+		t.Errorf("Unexpected admin contact: %+v", state.AdminContact)
+	}
+	if got := state.AdminContact.ContactType.ValueString(); got != "PERSON" {
+		t.Errorf("Expected admin contact_type PERSON, got %q", got)
+	}
+	if got := state.RegistrantContact.ContactType.ValueString(); got != "COMPANY" {
+		t.Errorf("Expected registrant contact_type COMPANY, got %q", got)
+	}
+	if state.TechContact == nil || state.TechContact.Email.ValueString() != "redacted@example.com" {
+		t.Errorf("Expected the privacy-protected tech contact as reported, got %+v", state.TechContact)
+	}
+	if state.BillingContact != nil || !state.BillingPrivacy.IsNull() {
+		t.Errorf("Expected no billing contact, got %+v (privacy %s)", state.BillingContact, state.BillingPrivacy)
+	}
+	if state.AdminPrivacy.ValueBool() || state.RegistrantPrivacy.ValueBool() || !state.TechPrivacy.ValueBool() {
+		t.Errorf("Unexpected privacy flags: admin=%s registrant=%s tech=%s", state.AdminPrivacy, state.RegistrantPrivacy, state.TechPrivacy)
+	}
+}
+
+func TestDomainContactsDataSourceRead_error(t *testing.T) {
+	d := &DomainContactsDataSource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return nil, errors.New("domain not found")
+			},
+		},
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainContactsDataSourceModel{
+		DomainName: tftypes.StringValue("example.com"),
+	})
+	d.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error diagnostic")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Error reading domain contacts" {
+		t.Errorf("Unexpected diagnostic summary: %s", summary)
+	}
+}
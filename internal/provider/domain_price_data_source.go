@@ -4,27 +4,36 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &DomainPriceDataSource{}
 
 type DomainPriceDataSource struct {
-	client *route53domains.Client
+	client     Route53DomainsAPI
+	priceCache *PriceCache
 }
 
 type DomainPriceDataSourceModel struct {
-	ID                   types.String  `tfsdk:"id"`
-	TLD                  types.String  `tfsdk:"tld"`
-	RegistrationPrice    types.Float64 `tfsdk:"registration_price"`
-	RenewalPrice         types.Float64 `tfsdk:"renewal_price"`
-	TransferPrice        types.Float64 `tfsdk:"transfer_price"`
-	ChangeOwnershipPrice types.Float64 `tfsdk:"change_ownership_price"`
-	RestorationPrice     types.Float64 `tfsdk:"restoration_price"`
-	Currency             types.String  `tfsdk:"currency"`
+	ID                      types.String  `tfsdk:"id"`
+	TLD                     types.String  `tfsdk:"tld"`
+	RegistrationPrice       types.Float64 `tfsdk:"registration_price"`
+	RenewalPrice            types.Float64 `tfsdk:"renewal_price"`
+	TransferPrice           types.Float64 `tfsdk:"transfer_price"`
+	ChangeOwnershipPrice    types.Float64 `tfsdk:"change_ownership_price"`
+	RestorationPrice        types.Float64 `tfsdk:"restoration_price"`
+	Currency                types.String  `tfsdk:"currency"`
+	RenewalCurrency         types.String  `tfsdk:"renewal_currency"`
+	TransferCurrency        types.String  `tfsdk:"transfer_currency"`
+	ChangeOwnershipCurrency types.String  `tfsdk:"change_ownership_currency"`
+	RestorationCurrency     types.String  `tfsdk:"restoration_currency"`
+	DurationYears           types.Int64   `tfsdk:"duration_years"`
+	TotalRegistrationPrice  types.Float64 `tfsdk:"total_registration_price"`
 }
 
 func NewDomainPriceDataSource() datasource.DataSource {
@@ -45,7 +54,7 @@ func (d *DomainPriceDataSource) Schema(ctx context.Context, req datasource.Schem
 			},
 			"tld": schema.StringAttribute{
 				Required:    true,
-				Description: "The top-level domain (e.g., 'com', 'net', 'org').",
+				Description: "The top-level domain (e.g., 'com', 'net', 'org'). Case and a leading dot are ignored.",
 			},
 			"registration_price": schema.Float64Attribute{
 				Computed:    true,
@@ -68,8 +77,33 @@ func (d *DomainPriceDataSource) Schema(ctx context.Context, req datasource.Schem
 				Description: "Price to restore a deleted domain.",
 			},
 			"currency": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Currency code (e.g., USD).",
+				Description: "Currency code of the registration price (e.g., USD). If set, the lookup fails unless every returned price is in this currency.",
+			},
+			"renewal_currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Currency code of the renewal price.",
+			},
+			"transfer_currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Currency code of the transfer price.",
+			},
+			"change_ownership_currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Currency code of the change ownership price.",
+			},
+			"restoration_currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Currency code of the restoration price.",
+			},
+			"duration_years": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of years (1-10) to price a registration for in total_registration_price (default: 1).",
+			},
+			"total_registration_price": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Price to register a domain for duration_years: the registration price for the first year plus the renewal price for each further year.",
 			},
 		},
 	}
@@ -90,6 +124,7 @@ func (d *DomainPriceDataSource) Configure(ctx context.Context, req datasource.Co
 	}
 
 	d.client = providerData.DomainsClient
+	d.priceCache = providerData.PriceCache
 }
 
 func (d *DomainPriceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -102,58 +137,111 @@ func (d *DomainPriceDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	tld := data.TLD.ValueString()
 
-	// List prices and find the one for our TLD
-	paginator := route53domains.NewListPricesPaginator(d.client, &route53domains.ListPricesInput{
-		Tld: &tld,
-	})
-
-	var found bool
-	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(ctx)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error listing domain prices",
-				fmt.Sprintf("Could not list prices for TLD %s: %s", tld, err.Error()),
+	years := int64(1)
+	if !data.DurationYears.IsNull() {
+		years = data.DurationYears.ValueInt64()
+		if years < 1 || years > 10 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duration_years"),
+				"Invalid duration_years",
+				fmt.Sprintf("duration_years must be between 1 and 10, got %d.", years),
 			)
 			return
 		}
+	}
+
+	price, err := d.priceCache.Get(ctx, tld, func(ctx context.Context) ([]awstypes.DomainPrice, error) {
+		return listAllPrices(ctx, d.client)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing domain prices",
+			fmt.Sprintf("Could not list prices for TLD %s: %s", tld, err.Error()),
+		)
+		return
+	}
 
-		for _, price := range page.Prices {
-			if price.Name != nil && *price.Name == tld {
-				found = true
-				data.ID = types.StringValue(tld)
-
-				if price.RegistrationPrice != nil {
-					data.RegistrationPrice = types.Float64Value(price.RegistrationPrice.Price)
-					data.Currency = types.StringValue(*price.RegistrationPrice.Currency)
-				}
-				if price.RenewalPrice != nil {
-					data.RenewalPrice = types.Float64Value(price.RenewalPrice.Price)
-				}
-				if price.TransferPrice != nil {
-					data.TransferPrice = types.Float64Value(price.TransferPrice.Price)
-				}
-				if price.ChangeOwnershipPrice != nil {
-					data.ChangeOwnershipPrice = types.Float64Value(price.ChangeOwnershipPrice.Price)
-				}
-				if price.RestorationPrice != nil {
-					data.RestorationPrice = types.Float64Value(price.RestorationPrice.Price)
-				}
-				break
+	if price == nil {
+		resp.Diagnostics.AddError(
+			"TLD not found",
+			fmt.Sprintf("No pricing information found for TLD: %s", tld),
+		)
+		return
+	}
+
+	requestedCurrency := data.Currency
+
+	data.ID = types.StringValue(tld)
+	data.RegistrationPrice, data.Currency = priceWithCurrency(price.RegistrationPrice)
+	data.RenewalPrice, data.RenewalCurrency = priceWithCurrency(price.RenewalPrice)
+	data.TransferPrice, data.TransferCurrency = priceWithCurrency(price.TransferPrice)
+	data.ChangeOwnershipPrice, data.ChangeOwnershipCurrency = priceWithCurrency(price.ChangeOwnershipPrice)
+	data.RestorationPrice, data.RestorationCurrency = priceWithCurrency(price.RestorationPrice)
+
+	// If a currency was requested, every returned price must be in it
+	if !requestedCurrency.IsNull() && !requestedCurrency.IsUnknown() {
+		for _, c := range []struct {
+			operation string
+			currency  types.String
+		}{
+			{"registration", data.Currency},
+			{"renewal", data.RenewalCurrency},
+			{"transfer", data.TransferCurrency},
+			{"change ownership", data.ChangeOwnershipCurrency},
+			{"restoration", data.RestorationCurrency},
+		} {
+			if !c.currency.IsNull() && c.currency.ValueString() != requestedCurrency.ValueString() {
+				resp.Diagnostics.AddError(
+					"Currency not available",
+					fmt.Sprintf("The %s price for TLD %s is in %s, not the requested currency %s.", c.operation, tld, c.currency.ValueString(), requestedCurrency.ValueString()),
+				)
 			}
 		}
-		if found {
-			break
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		data.Currency = requestedCurrency
 	}
 
-	if !found {
+	total, err := totalRegistrationPrice(price, years)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"TLD not found",
-			fmt.Sprintf("No pricing information found for TLD: %s", tld),
+			"Cannot compute total registration price",
+			fmt.Sprintf("Could not price a %d-year registration for TLD %s: %s", years, tld, err.Error()),
 		)
 		return
 	}
+	data.TotalRegistrationPrice = total
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// totalRegistrationPrice prices a registration for years: the first year at
+// the registration price and each further year at the renewal price. It is
+// null when the TLD has no registration price.
+func totalRegistrationPrice(price *awstypes.DomainPrice, years int64) (types.Float64, error) {
+	if price.RegistrationPrice == nil {
+		return types.Float64Null(), nil
+	}
+	total := price.RegistrationPrice.Price
+	if years > 1 {
+		renewal := price.RenewalPrice
+		if renewal == nil {
+			return types.Float64Null(), fmt.Errorf("no renewal price is available")
+		}
+		if aws.ToString(renewal.Currency) != aws.ToString(price.RegistrationPrice.Currency) {
+			return types.Float64Null(), fmt.Errorf("the registration price is in %s but the renewal price is in %s", aws.ToString(price.RegistrationPrice.Currency), aws.ToString(renewal.Currency))
+		}
+		total += float64(years-1) * renewal.Price
+	}
+	return types.Float64Value(total), nil
+}
+
+// priceWithCurrency splits an AWS price into its amount and currency, both
+// null when the operation has no price.
+func priceWithCurrency(p *awstypes.PriceWithCurrency) (types.Float64, types.String) {
+	if p == nil {
+		return types.Float64Null(), types.StringNull()
+	}
+	return types.Float64Value(p.Price), types.StringPointerValue(p.Currency)
+}
@@ -1,8 +1,14 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -49,3 +55,267 @@ data "awsdomains_domain_price" "test" {
 }
 `
 }
+
+func TestDomainPriceDataSourceConfigure(t *testing.T) {
+	client := &MockRoute53DomainsClient{}
+	cache := NewPriceCache(defaultPriceCacheTTL)
+	d := &DomainPriceDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{
+		ProviderData: &ProviderData{DomainsClient: client, PriceCache: cache},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+	if d.client != client {
+		t.Errorf("Expected the DomainsClient from ProviderData, got %v", d.client)
+	}
+	if d.priceCache != cache {
+		t.Error("Expected the PriceCache from ProviderData")
+	}
+}
+
+func TestDomainPriceDataSourceRead_cachesRepeatedLookups(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	client := &MockRoute53DomainsClient{
+		ListPricesFunc: func(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error) {
+			calls++
+			return &route53domains.ListPricesOutput{
+				Prices: []types.DomainPrice{
+					{
+						Name:              aws.String("com"),
+						RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+						RenewalPrice:      &types.PriceWithCurrency{Price: 15, Currency: aws.String("USD")},
+					},
+				},
+			}, nil
+		},
+	}
+	cache := NewPriceCache(defaultPriceCacheTTL)
+
+	for i := 0; i < 3; i++ {
+		// Each data source block gets its own instance sharing the provider's cache
+		d := &DomainPriceDataSource{client: client, priceCache: cache}
+		req, resp := newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+			TLD: tftypes.StringValue("com"),
+		})
+		d.Read(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+		}
+
+		var state DomainPriceDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		if state.RegistrationPrice.ValueFloat64() != 14 {
+			t.Errorf("registration_price mismatch: got %v, want 14", state.RegistrationPrice.ValueFloat64())
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected ListPrices to be called once, got %d", calls)
+	}
+}
+
+func TestDomainPriceDataSourceRead_differentTLDsShareOneListing(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	client := &MockRoute53DomainsClient{
+		ListPricesFunc: func(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error) {
+			calls++
+			if params.Tld != nil {
+				t.Errorf("Expected an unfiltered listing, got TLD filter %s", *params.Tld)
+			}
+			// Two pages, one TLD each
+			if params.Marker == nil {
+				return &route53domains.ListPricesOutput{
+					Prices:         []types.DomainPrice{{Name: aws.String("com"), RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")}}},
+					NextPageMarker: aws.String("page-2"),
+				}, nil
+			}
+			return &route53domains.ListPricesOutput{
+				Prices: []types.DomainPrice{{Name: aws.String("net"), RegistrationPrice: &types.PriceWithCurrency{Price: 16, Currency: aws.String("USD")}}},
+			}, nil
+		},
+	}
+	cache := NewPriceCache(defaultPriceCacheTTL)
+
+	for tld, want := range map[string]float64{"com": 14, "net": 16} {
+		d := &DomainPriceDataSource{client: client, priceCache: cache}
+		req, resp := newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+			TLD: tftypes.StringValue(tld),
+		})
+		d.Read(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read returned errors for %s: %v", tld, resp.Diagnostics)
+		}
+
+		var state DomainPriceDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		if state.RegistrationPrice.ValueFloat64() != want {
+			t.Errorf("%s registration_price mismatch: got %v, want %v", tld, state.RegistrationPrice.ValueFloat64(), want)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected a single two-page pagination, got %d ListPrices calls", calls)
+	}
+}
+
+// mockPriceClient returns a client whose ListPrices returns the given price
+// for the com TLD.
+func TestDomainPriceDataSourceRead_normalizesTLD(t *testing.T) {
+	ctx := context.Background()
+	d := &DomainPriceDataSource{
+		client: &MockRoute53DomainsClient{
+			ListPricesFunc: func(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error) {
+				return &route53domains.ListPricesOutput{Prices: []types.DomainPrice{{
+					Name:              aws.String(".com"),
+					RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+				}}}, nil
+			},
+		},
+	}
+
+	for _, tld := range []string{"com", ".COM", "COM"} {
+		t.Run(tld, func(t *testing.T) {
+			req, resp := newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+				TLD: tftypes.StringValue(tld),
+			})
+			d.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var state DomainPriceDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.RegistrationPrice.ValueFloat64() != 14 {
+				t.Errorf("Expected registration_price 14, got %v", state.RegistrationPrice)
+			}
+		})
+	}
+}
+
+func mockPriceClient(price types.DomainPrice) *MockRoute53DomainsClient {
+	price.Name = aws.String("com")
+	return &MockRoute53DomainsClient{
+		ListPricesFunc: func(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error) {
+			return &route53domains.ListPricesOutput{Prices: []types.DomainPrice{price}}, nil
+		},
+	}
+}
+
+func TestDomainPriceDataSourceRead_perOperationCurrency(t *testing.T) {
+	ctx := context.Background()
+	d := &DomainPriceDataSource{
+		client: mockPriceClient(types.DomainPrice{
+			RegistrationPrice:    &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+			RenewalPrice:         &types.PriceWithCurrency{Price: 13, Currency: aws.String("EUR")},
+			TransferPrice:        &types.PriceWithCurrency{Price: 12, Currency: aws.String("GBP")},
+			ChangeOwnershipPrice: &types.PriceWithCurrency{Price: 0, Currency: aws.String("JPY")},
+			RestorationPrice:     &types.PriceWithCurrency{Price: 99, Currency: aws.String("CAD")},
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+		TLD: tftypes.StringValue("com"),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var state DomainPriceDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+
+	checks := map[string][2]string{
+		"currency":                  {state.Currency.ValueString(), "USD"},
+		"renewal_currency":          {state.RenewalCurrency.ValueString(), "EUR"},
+		"transfer_currency":         {state.TransferCurrency.ValueString(), "GBP"},
+		"change_ownership_currency": {state.ChangeOwnershipCurrency.ValueString(), "JPY"},
+		"restoration_currency":      {state.RestorationCurrency.ValueString(), "CAD"},
+	}
+	for attr, c := range checks {
+		if c[0] != c[1] {
+			t.Errorf("%s mismatch: got %s, want %s", attr, c[0], c[1])
+		}
+	}
+}
+
+func TestDomainPriceDataSourceRead_totalRegistrationPrice(t *testing.T) {
+	ctx := context.Background()
+	d := &DomainPriceDataSource{
+		client: mockPriceClient(types.DomainPrice{
+			RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+			RenewalPrice:      &types.PriceWithCurrency{Price: 15.5, Currency: aws.String("USD")},
+		}),
+	}
+
+	tests := []struct {
+		name  string
+		years tftypes.Int64
+		want  float64
+	}{
+		{"default one year", tftypes.Int64Null(), 14},
+		{"three years", tftypes.Int64Value(3), 14 + 2*15.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, resp := newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+				TLD:           tftypes.StringValue("com"),
+				DurationYears: tt.years,
+			})
+			d.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var state DomainPriceDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if got := state.TotalRegistrationPrice.ValueFloat64(); got != tt.want {
+				t.Errorf("Expected total_registration_price %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+		TLD:           tftypes.StringValue("com"),
+		DurationYears: tftypes.Int64Value(11),
+	})
+	d.Read(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for duration_years above 10")
+	}
+}
+
+func TestDomainPriceDataSourceRead_requestedCurrency(t *testing.T) {
+	ctx := context.Background()
+	d := &DomainPriceDataSource{
+		client: mockPriceClient(types.DomainPrice{
+			RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+			RenewalPrice:      &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+		}),
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+		TLD:      tftypes.StringValue("com"),
+		Currency: tftypes.StringValue("USD"),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected matching currency to succeed: %v", resp.Diagnostics)
+	}
+
+	req, resp = newDataSourceReadRequest(t, d, &DomainPriceDataSourceModel{
+		TLD:      tftypes.StringValue("com"),
+		Currency: tftypes.StringValue("EUR"),
+	})
+	d.Read(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for an unavailable currency")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Currency not available" {
+		t.Errorf("Unexpected diagnostic summary: %s", summary)
+	}
+}
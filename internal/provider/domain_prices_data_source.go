@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DomainPricesDataSource{}
+
+type DomainPricesDataSource struct {
+	client     Route53DomainsAPI
+	priceCache *PriceCache
+}
+
+type DomainPricesDataSourceModel struct {
+	ID     types.String              `tfsdk:"id"`
+	TLDs   []types.String            `tfsdk:"tlds"`
+	Prices map[string]TLDPricesModel `tfsdk:"prices"`
+}
+
+// TLDPricesModel is the price of each operation for one TLD.
+type TLDPricesModel struct {
+	RegistrationPrice       types.Float64 `tfsdk:"registration_price"`
+	RenewalPrice            types.Float64 `tfsdk:"renewal_price"`
+	TransferPrice           types.Float64 `tfsdk:"transfer_price"`
+	ChangeOwnershipPrice    types.Float64 `tfsdk:"change_ownership_price"`
+	RestorationPrice        types.Float64 `tfsdk:"restoration_price"`
+	Currency                types.String  `tfsdk:"currency"`
+	RenewalCurrency         types.String  `tfsdk:"renewal_currency"`
+	TransferCurrency        types.String  `tfsdk:"transfer_currency"`
+	ChangeOwnershipCurrency types.String  `tfsdk:"change_ownership_currency"`
+	RestorationCurrency     types.String  `tfsdk:"restoration_currency"`
+}
+
+func NewDomainPricesDataSource() datasource.DataSource {
+	return &DomainPricesDataSource{}
+}
+
+func (d *DomainPricesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_prices"
+}
+
+func (d *DomainPricesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get pricing information for multiple TLDs from a single price listing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Comma-separated list of the priced TLDs.",
+			},
+			"tlds": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The top-level domains to price (e.g., 'com', 'net', 'org'). Case and a leading dot are ignored.",
+			},
+			"prices": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Prices keyed by TLD, lowercase and without a leading dot.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"registration_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to register a new domain.",
+						},
+						"renewal_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to renew a domain.",
+						},
+						"transfer_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to transfer a domain.",
+						},
+						"change_ownership_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to change domain ownership.",
+						},
+						"restoration_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to restore a deleted domain.",
+						},
+						"currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency code of the registration price (e.g., USD).",
+						},
+						"renewal_currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency code of the renewal price.",
+						},
+						"transfer_currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency code of the transfer price.",
+						},
+						"change_ownership_currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency code of the change ownership price.",
+						},
+						"restoration_currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency code of the restoration price.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainPricesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.DomainsClient
+	d.priceCache = providerData.PriceCache
+}
+
+func (d *DomainPricesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainPricesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// One listing covers every TLD, so it is fetched once rather than per TLD
+	prices, err := d.priceCache.Prices(ctx, func(ctx context.Context) ([]awstypes.DomainPrice, error) {
+		return listAllPrices(ctx, d.client)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing domain prices",
+			fmt.Sprintf("Could not list domain prices: %s", err.Error()),
+		)
+		return
+	}
+	byTLD := make(map[string]*awstypes.DomainPrice, len(prices))
+	for i := range prices {
+		if prices[i].Name != nil {
+			byTLD[normalizeTLD(*prices[i].Name)] = &prices[i]
+		}
+	}
+
+	var tlds, missing []string
+	data.Prices = make(map[string]TLDPricesModel, len(data.TLDs))
+	for _, t := range data.TLDs {
+		tld := normalizeTLD(t.ValueString())
+		price, ok := byTLD[tld]
+		if !ok {
+			missing = append(missing, t.ValueString())
+			continue
+		}
+		if _, seen := data.Prices[tld]; !seen {
+			tlds = append(tlds, tld)
+		}
+		data.Prices[tld] = tldPricesModel(price)
+	}
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tlds"),
+			"TLD not found",
+			fmt.Sprintf("No pricing information found for TLDs: %s", strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join(tlds, ","))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func tldPricesModel(price *awstypes.DomainPrice) TLDPricesModel {
+	var m TLDPricesModel
+	m.RegistrationPrice, m.Currency = priceWithCurrency(price.RegistrationPrice)
+	m.RenewalPrice, m.RenewalCurrency = priceWithCurrency(price.RenewalPrice)
+	m.TransferPrice, m.TransferCurrency = priceWithCurrency(price.TransferPrice)
+	m.ChangeOwnershipPrice, m.ChangeOwnershipCurrency = priceWithCurrency(price.ChangeOwnershipPrice)
+	m.RestorationPrice, m.RestorationCurrency = priceWithCurrency(price.RestorationPrice)
+	return m
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomainPricesDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	client := &MockRoute53DomainsClient{
+		ListPricesFunc: func(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error) {
+			calls++
+			if params.Tld != nil {
+				t.Errorf("Expected an unfiltered listing, got TLD filter %s", *params.Tld)
+			}
+			return &route53domains.ListPricesOutput{Prices: []types.DomainPrice{
+				{Name: aws.String("com"), RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")}, RenewalPrice: &types.PriceWithCurrency{Price: 15, Currency: aws.String("USD")}},
+				{Name: aws.String("net"), RegistrationPrice: &types.PriceWithCurrency{Price: 16, Currency: aws.String("USD")}},
+				{Name: aws.String(".org"), RegistrationPrice: &types.PriceWithCurrency{Price: 13, Currency: aws.String("USD")}},
+				{Name: aws.String("io"), RegistrationPrice: &types.PriceWithCurrency{Price: 71, Currency: aws.String("USD")}},
+				{Name: aws.String("dev"), RegistrationPrice: &types.PriceWithCurrency{Price: 17, Currency: aws.String("USD")}},
+			}}, nil
+		},
+	}
+
+	d := &DomainPricesDataSource{client: client, priceCache: NewPriceCache(defaultPriceCacheTTL)}
+	req, resp := newDataSourceReadRequest(t, d, &DomainPricesDataSourceModel{
+		TLDs: []tftypes.String{tftypes.StringValue("com"), tftypes.StringValue("net"), tftypes.StringValue("org"), tftypes.StringValue(".IO")},
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var state DomainPricesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	want := map[string]float64{"com": 14, "net": 16, "org": 13, "io": 71}
+	if len(state.Prices) != len(want) {
+		t.Errorf("Expected prices for %d TLDs, got %v", len(want), state.Prices)
+	}
+	for tld, price := range want {
+		got, ok := state.Prices[tld]
+		if !ok {
+			t.Errorf("Expected a price for %s", tld)
+			continue
+		}
+		if got.RegistrationPrice.ValueFloat64() != price || got.Currency.ValueString() != "USD" {
+			t.Errorf("%s: expected registration price %v USD, got %v %s", tld, price, got.RegistrationPrice, got.Currency)
+		}
+	}
+	if got := state.Prices["com"].RenewalPrice.ValueFloat64(); got != 15 {
+		t.Errorf("Expected com renewal_price 15, got %v", got)
+	}
+	if !state.Prices["net"].RenewalPrice.IsNull() {
+		t.Errorf("Expected a null net renewal_price, got %v", state.Prices["net"].RenewalPrice)
+	}
+	if state.ID.ValueString() != "com,net,org,io" {
+		t.Errorf("Expected id com,net,org,io, got %s", state.ID)
+	}
+	if calls != 1 {
+		t.Errorf("Expected a single ListPrices call, got %d", calls)
+	}
+}
+
+func TestDomainPricesDataSourceRead_unknownTLD(t *testing.T) {
+	d := &DomainPricesDataSource{client: mockPriceClient(types.DomainPrice{
+		RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+	})}
+	req, resp := newDataSourceReadRequest(t, d, &DomainPricesDataSourceModel{
+		TLDs: []tftypes.String{tftypes.StringValue("com"), tftypes.StringValue("notatld")},
+	})
+	d.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "TLD not found" {
+		t.Fatalf("Expected a TLD not found error, got %v", resp.Diagnostics)
+	}
+}
@@ -2,66 +2,222 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"net/netip"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/idna"
 )
 
 var _ resource.Resource = &DomainRegistrationResource{}
 var _ resource.ResourceWithImportState = &DomainRegistrationResource{}
+var _ resource.ResourceWithUpgradeState = &DomainRegistrationResource{}
+var _ resource.ResourceWithValidateConfig = &DomainRegistrationResource{}
+var _ resource.ResourceWithModifyPlan = &DomainRegistrationResource{}
+
+// defaultDeleteTimeout is the default delete_timeout in seconds.
+const defaultDeleteTimeout = 900
+
+// registrarZoneComment is the comment Route53 Registrar sets on the hosted
+// zone it creates, and the default registrar_zone_comments.
+const registrarZoneComment = "HostedZone created by Route53 Registrar"
+
+// hostedZoneWaitTimeout bounds how long Create waits for the registrar hosted
+// zone to appear before giving up on delete_hosted_zone.
+const hostedZoneWaitTimeout = 5 * time.Minute
+
+// hostedZonePollInterval is how often Route53 is polled while waiting for the
+// registrar hosted zone. Tests shorten it.
+var hostedZonePollInterval = 5 * time.Second
+
+// errHostedZoneNotFound is returned when no hosted zone matches the domain.
+var errHostedZoneNotFound = errors.New("hosted zone not found")
+
+// zoneSafetyCheck names a deleteRegistrarHostedZone safety check.
+type zoneSafetyCheck string
+
+const (
+	zoneCheckPrivate zoneSafetyCheck = "private zone"
+	zoneCheckComment zoneSafetyCheck = "comment mismatch"
+	zoneCheckRecords zoneSafetyCheck = "custom records"
+)
+
+// zoneSafetyError is returned by deleteRegistrarHostedZone when a safety
+// check keeps the hosted zone, so callers can tell the user which one.
+type zoneSafetyError struct {
+	ZoneID string
+	Check  zoneSafetyCheck
+	Detail string
+}
+
+func (e *zoneSafetyError) Error() string {
+	return fmt.Sprintf("hosted zone %s not deleted (%s): %s", e.ZoneID, e.Check, e.Detail)
+}
+
+// addZoneDeletionWarning adds a warning explaining why the hosted zone of
+// domainName was not deleted, naming the failed safety check when there is one.
+func addZoneDeletionWarning(diags *diag.Diagnostics, domainName string, err error) {
+	var safety *zoneSafetyError
+	if !errors.As(err, &safety) {
+		diags.AddWarning(
+			"Could not delete hosted zone",
+			fmt.Sprintf("The hosted zone of %s was not deleted: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	switch safety.Check {
+	case zoneCheckPrivate:
+		diags.AddWarning(
+			"Hosted zone is private",
+			fmt.Sprintf("The hosted zone %s of %s was not deleted: %s. Only the public zone the registrar creates is deleted.", safety.ZoneID, domainName, safety.Detail),
+		)
+	case zoneCheckComment:
+		diags.AddAttributeWarning(
+			path.Root("registrar_zone_comments"),
+			"Hosted zone comment not recognized",
+			fmt.Sprintf("The hosted zone %s of %s was not deleted: %s. If the registrar created this zone, add its comment to registrar_zone_comments.", safety.ZoneID, domainName, safety.Detail),
+		)
+	case zoneCheckRecords:
+		diags.AddWarning(
+			"Hosted zone has custom records",
+			fmt.Sprintf("The hosted zone %s of %s was not deleted: %s. Zones with records other than NS, SOA and deletable_zone_records are kept; delete the records, or the zone, if it is no longer needed, or add them to deletable_zone_records.", safety.ZoneID, domainName, safety.Detail),
+		)
+	}
+}
 
 type DomainRegistrationResource struct {
-	client        *route53domains.Client
-	route53Client *route53.Client
+	client           Route53DomainsAPI
+	route53Client    Route53API
+	defaultTags      map[string]string
+	contactTemplates map[string]ContactModel
 }
 
 type ContactModel struct {
-	FirstName    tftypes.String `tfsdk:"first_name"`
-	LastName     tftypes.String `tfsdk:"last_name"`
-	Email        tftypes.String `tfsdk:"email"`
-	PhoneNumber  tftypes.String `tfsdk:"phone_number"`
-	AddressLine1 tftypes.String `tfsdk:"address_line_1"`
-	AddressLine2 tftypes.String `tfsdk:"address_line_2"`
-	City         tftypes.String `tfsdk:"city"`
-	State        tftypes.String `tfsdk:"state"`
-	ZipCode      tftypes.String `tfsdk:"zip_code"`
-	CountryCode  tftypes.String `tfsdk:"country_code"`
-	ContactType  tftypes.String `tfsdk:"contact_type"`
+	FirstName    tftypes.String            `tfsdk:"first_name"`
+	LastName     tftypes.String            `tfsdk:"last_name"`
+	Organization tftypes.String            `tfsdk:"organization_name"`
+	Email        tftypes.String            `tfsdk:"email"`
+	PhoneNumber  tftypes.String            `tfsdk:"phone_number"`
+	AddressLine1 tftypes.String            `tfsdk:"address_line_1"`
+	AddressLine2 tftypes.String            `tfsdk:"address_line_2"`
+	City         tftypes.String            `tfsdk:"city"`
+	State        tftypes.String            `tfsdk:"state"`
+	ZipCode      tftypes.String            `tfsdk:"zip_code"`
+	CountryCode  tftypes.String            `tfsdk:"country_code"`
+	ContactType  tftypes.String            `tfsdk:"contact_type"`
+	ExtraParams  map[string]tftypes.String `tfsdk:"extra_params"`
+}
+
+// TimeoutsModel is the timeouts block. Values are Go duration strings.
+type TimeoutsModel struct {
+	Create tftypes.String `tfsdk:"create"`
+}
+
+type NameserverModel struct {
+	Name    tftypes.String   `tfsdk:"name"`
+	GlueIPs []tftypes.String `tfsdk:"glue_ips"`
+}
+
+// DnssecKeyModel is a DNSSEC public key whose DS record is published in the
+// parent zone.
+type DnssecKeyModel struct {
+	Algorithm tftypes.Int64  `tfsdk:"algorithm"`
+	Flags     tftypes.Int64  `tfsdk:"flags"`
+	PublicKey tftypes.String `tfsdk:"public_key"`
+}
+
+// ZoneRecordModel is a record that does not keep the registrar-created hosted
+// zone from being deleted. A null name matches records of the type at any name.
+type ZoneRecordModel struct {
+	Type tftypes.String `tfsdk:"type"`
+	Name tftypes.String `tfsdk:"name"`
 }
 
 type DomainRegistrationResourceModel struct {
-	ID                  tftypes.String   `tfsdk:"id"`
-	DomainName          tftypes.String   `tfsdk:"domain_name"`
-	DurationYears       tftypes.Int64    `tfsdk:"duration_years"`
-	AutoRenew           tftypes.Bool     `tfsdk:"auto_renew"`
-	AdminContact        *ContactModel    `tfsdk:"admin_contact"`
-	RegistrantContact   *ContactModel    `tfsdk:"registrant_contact"`
-	TechContact         *ContactModel    `tfsdk:"tech_contact"`
-	AdminPrivacy        tftypes.Bool     `tfsdk:"admin_privacy"`
-	RegistrantPrivacy   tftypes.Bool     `tfsdk:"registrant_privacy"`
-	TechPrivacy         tftypes.Bool     `tfsdk:"tech_privacy"`
-	Nameservers         []tftypes.String `tfsdk:"nameservers"`
-	AllowDelete         tftypes.Bool     `tfsdk:"allow_delete"`
-	DeleteHostedZone    tftypes.Bool     `tfsdk:"delete_hosted_zone"`
-	Status              tftypes.String   `tfsdk:"status"`
-	ExpirationDate      tftypes.String   `tfsdk:"expiration_date"`
-	CreationDate        tftypes.String   `tfsdk:"creation_date"`
-	RegistrationTimeout tftypes.Int64    `tfsdk:"registration_timeout"`
-	HostedZoneID        tftypes.String   `tfsdk:"hosted_zone_id"`
+	ID                        tftypes.String            `tfsdk:"id"`
+	DomainName                tftypes.String            `tfsdk:"domain_name"`
+	DurationYears             tftypes.Int64             `tfsdk:"duration_years"`
+	AutoRenew                 tftypes.Bool              `tfsdk:"auto_renew"`
+	Contact                   *ContactModel             `tfsdk:"contact"`
+	AdminContact              *ContactModel             `tfsdk:"admin_contact"`
+	RegistrantContact         *ContactModel             `tfsdk:"registrant_contact"`
+	TechContact               *ContactModel             `tfsdk:"tech_contact"`
+	BillingContact            *ContactModel             `tfsdk:"billing_contact"`
+	AdminContactTemplate      tftypes.String            `tfsdk:"admin_contact_template"`
+	RegistrantContactTemplate tftypes.String            `tfsdk:"registrant_contact_template"`
+	TechContactTemplate       tftypes.String            `tfsdk:"tech_contact_template"`
+	ContactTemplateHashes     tftypes.Map               `tfsdk:"contact_template_hashes"`
+	AdminPrivacy              tftypes.Bool              `tfsdk:"admin_privacy"`
+	RegistrantPrivacy         tftypes.Bool              `tfsdk:"registrant_privacy"`
+	TechPrivacy               tftypes.Bool              `tfsdk:"tech_privacy"`
+	BillingPrivacy            tftypes.Bool              `tfsdk:"billing_privacy"`
+	Nameservers               []NameserverModel         `tfsdk:"nameservers"`
+	DnssecKeys                []DnssecKeyModel          `tfsdk:"dnssec_keys"`
+	AllowDelete               tftypes.Bool              `tfsdk:"allow_delete"`
+	DeleteHostedZone          tftypes.Bool              `tfsdk:"delete_hosted_zone"`
+	ManageHostedZone          tftypes.Bool              `tfsdk:"manage_hosted_zone"`
+	RegistrarZoneComments     []tftypes.String          `tfsdk:"registrar_zone_comments"`
+	DeletableZoneRecords      []ZoneRecordModel         `tfsdk:"deletable_zone_records"`
+	Tags                      map[string]tftypes.String `tfsdk:"tags"`
+	TagsAll                   tftypes.Map               `tfsdk:"tags_all"`
+	Status                    tftypes.String            `tfsdk:"status"`
+	ExpirationDate            tftypes.String            `tfsdk:"expiration_date"`
+	DaysUntilExpiry           tftypes.Int64             `tfsdk:"days_until_expiry"`
+	CreationDate              tftypes.String            `tfsdk:"creation_date"`
+	RegistrationTimeout       tftypes.Int64             `tfsdk:"registration_timeout"`
+	DeleteTimeout             tftypes.Int64             `tfsdk:"delete_timeout"`
+	UnlockBeforeDelete        tftypes.Bool              `tfsdk:"unlock_before_delete"`
+	HostedZoneID              tftypes.String            `tfsdk:"hosted_zone_id"`
+	RegistrarNameservers      tftypes.List              `tfsdk:"registrar_nameservers"`
+	OperationID               tftypes.String            `tfsdk:"operation_id"`
+	ReachabilityStatus        tftypes.String            `tfsdk:"reachability_status"`
+	AdminContactHash          tftypes.String            `tfsdk:"admin_contact_hash"`
+	RegistrantContactHash     tftypes.String            `tfsdk:"registrant_contact_hash"`
+	TechContactHash           tftypes.String            `tfsdk:"tech_contact_hash"`
+	WhoisPrivacyEffective     tftypes.Map               `tfsdk:"whois_privacy_effective"`
+	Reseller                  tftypes.String            `tfsdk:"reseller"`
+	WhoIsServer               tftypes.String            `tfsdk:"whois_server"`
+	RegistrarName             tftypes.String            `tfsdk:"registrar_name"`
+	Registrar                 tftypes.String            `tfsdk:"registrar"`
+	RegistrarURL              tftypes.String            `tfsdk:"registrar_url"`
+	AbuseContactEmail         tftypes.String            `tfsdk:"abuse_contact_email"`
+	AbuseContactPhone         tftypes.String            `tfsdk:"abuse_contact_phone"`
+	ResendReachabilityEmail   tftypes.Bool              `tfsdk:"resend_reachability_email"`
+	ValidateAvailability      tftypes.Bool              `tfsdk:"validate_availability"`
+	AllowRegistrantChange     tftypes.Bool              `tfsdk:"allow_registrant_change"`
+	SkipDetailRefresh         tftypes.Bool              `tfsdk:"skip_detail_refresh"`
+	Timeouts                  *TimeoutsModel            `tfsdk:"timeouts"`
 }
 
 func NewDomainRegistrationResource() resource.Resource {
@@ -72,61 +228,63 @@ func (r *DomainRegistrationResource) Metadata(ctx context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_domain"
 }
 
-func contactSchema() schema.SingleNestedAttribute {
+// contactField describes one attribute of a contact. Contacts on
+// awsdomains_domain and the provider's contact_templates are both built from
+// contactFields, so the two stay in step.
+type contactField struct {
+	name        string
+	description string
+	required    bool
+	validators  []validator.String
+	// stringMap makes the attribute a map of strings rather than a string.
+	stringMap bool
+}
+
+var contactFields = []contactField{
+	{name: "first_name", description: "First name of the contact.", required: true},
+	{name: "last_name", description: "Last name of the contact.", required: true},
+	{name: "organization_name", description: "Name of the organization. Required when contact_type is COMPANY or ASSOCIATION."},
+	{name: "email", description: "Email address of the contact.", required: true, validators: []validator.String{emailValidator{}}},
+	{name: "phone_number", description: "Phone number in E.164 format (e.g., +1.5551234567).", required: true},
+	{name: "address_line_1", description: "First line of the street address.", required: true},
+	{name: "address_line_2", description: "Second line of the street address."},
+	{name: "city", description: "City name.", required: true},
+	{name: "state", description: "State or province.", required: true},
+	{name: "zip_code", description: "Postal/ZIP code.", required: true},
+	{name: "country_code", description: "Two-letter country code (e.g., US).", required: true},
+	{name: "contact_type", description: "Contact type: PERSON, COMPANY, ASSOCIATION, PUBLIC_BODY, or RESELLER."},
+	{name: "extra_params", description: "Additional values some TLDs require, keyed by AWS ExtraParam name (e.g., AU_ID_NUMBER for .com.au, CA_LEGAL_TYPE for .ca).", stringMap: true},
+}
+
+func contactSchema(description string) schema.SingleNestedAttribute {
+	attributes := make(map[string]schema.Attribute, len(contactFields))
+	for _, f := range contactFields {
+		if f.stringMap {
+			attributes[f.name] = schema.MapAttribute{
+				Required:    f.required,
+				Optional:    !f.required,
+				ElementType: tftypes.StringType,
+				Description: f.description,
+			}
+			continue
+		}
+		attributes[f.name] = schema.StringAttribute{
+			Required:    f.required,
+			Optional:    !f.required,
+			Description: f.description,
+			Validators:  f.validators,
+		}
+	}
 	return schema.SingleNestedAttribute{
-		Required:    true,
-		Description: "Contact information for domain registration.",
-		Attributes: map[string]schema.Attribute{
-			"first_name": schema.StringAttribute{
-				Required:    true,
-				Description: "First name of the contact.",
-			},
-			"last_name": schema.StringAttribute{
-				Required:    true,
-				Description: "Last name of the contact.",
-			},
-			"email": schema.StringAttribute{
-				Required:    true,
-				Description: "Email address of the contact.",
-			},
-			"phone_number": schema.StringAttribute{
-				Required:    true,
-				Description: "Phone number in E.164 format (e.g., +1.5551234567).",
-			},
-			"address_line_1": schema.StringAttribute{
-				Required:    true,
-				Description: "First line of the street address.",
-			},
-			"address_line_2": schema.StringAttribute{
-				Optional:    true,
-				Description: "Second line of the street address.",
-			},
-			"city": schema.StringAttribute{
-				Required:    true,
-				Description: "City name.",
-			},
-			"state": schema.StringAttribute{
-				Required:    true,
-				Description: "State or province.",
-			},
-			"zip_code": schema.StringAttribute{
-				Required:    true,
-				Description: "Postal/ZIP code.",
-			},
-			"country_code": schema.StringAttribute{
-				Required:    true,
-				Description: "Two-letter country code (e.g., US).",
-			},
-			"contact_type": schema.StringAttribute{
-				Optional:    true,
-				Description: "Contact type: PERSON, COMPANY, ASSOCIATION, PUBLIC_BODY, or RESELLER.",
-			},
-		},
+		Optional:    true,
+		Description: description,
+		Attributes:  attributes,
 	}
 }
 
 func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Registers and manages an AWS Route53 domain. By default, destroying this resource only removes it from Terraform state without deleting the actual domain. Set allow_delete = true to enable actual domain deletion on destroy.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -138,16 +296,23 @@ func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.Sc
 			},
 			"domain_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The domain name to register.",
+				Description: "The domain name to register. Internationalized names may be given in Unicode or punycode; both refer to the same domain.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						domainNameChanged,
+						"Changing domain_name to a different domain requires replacement.",
+						"Changing domain_name to a different domain requires replacement.",
+					),
 				},
 			},
 			"duration_years": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(1),
-				Description: "Number of years to register the domain for (1-10).",
+				Description: "Number of years to register the domain for (1-10). Increasing it renews the domain for the difference; it cannot be decreased. Not refreshed from AWS, so renewals made outside this attribute only show in expiration_date.",
+				PlanModifiers: []planmodifier.Int64{
+					durationYearsModifier{},
+				},
 			},
 			"auto_renew": schema.BoolAttribute{
 				Optional:    true,
@@ -155,9 +320,28 @@ func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.Sc
 				Default:     booldefault.StaticBool(false),
 				Description: "Whether to automatically renew the domain.",
 			},
-			"admin_contact":      contactSchema(),
-			"registrant_contact": contactSchema(),
-			"tech_contact":       contactSchema(),
+			"contact":            contactSchema("Contact used for every role that is not set individually with admin_contact, registrant_contact or tech_contact, or their templates."),
+			"admin_contact":      contactSchema("Administrative contact. Defaults to admin_contact_template, then contact."),
+			"registrant_contact": contactSchema("Registrant (owner) contact. Defaults to registrant_contact_template, then contact."),
+			"tech_contact":       contactSchema("Technical contact. Defaults to tech_contact_template, then contact."),
+			"admin_contact_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a provider contact_templates entry used as the administrative contact when admin_contact is not set.",
+			},
+			"registrant_contact_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a provider contact_templates entry used as the registrant contact when registrant_contact is not set.",
+			},
+			"tech_contact_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of a provider contact_templates entry used as the technical contact when tech_contact is not set.",
+			},
+			"contact_template_hashes": schema.MapAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "SHA-256 hash of each contact template in effect, keyed by role (admin, registrant, tech), so a change to a template in the provider configuration plans a contact update. Null when no role uses a template.",
+			},
+			"billing_contact": contactSchema("Billing contact, sent only when set; it does not default to contact. Whether the registry uses it depends on the TLD, but AWS stores it with the domain either way. For registries without a billing contact its extra_params are also sent on the registrant contact."),
 			"admin_privacy": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -176,22 +360,106 @@ func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.Sc
 				Default:     booldefault.StaticBool(true),
 				Description: "Enable WHOIS privacy for tech contact.",
 			},
-			"nameservers": schema.ListAttribute{
+			"billing_privacy": schema.BoolAttribute{
 				Optional:    true,
-				ElementType: tftypes.StringType,
-				Description: "List of nameserver hostnames for the domain.",
+				Description: "Enable WHOIS privacy for the billing contact. Sent only when set; leaving it unset keeps whatever AWS has.",
+			},
+			"nameservers": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "List of nameservers for the domain. Removing them all reverts to the nameservers of the registrar-created hosted zone.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Fully qualified hostname of the nameserver.",
+							Validators: []validator.String{
+								hostnameValidator{},
+							},
+						},
+						"glue_ips": schema.ListAttribute{
+							Optional:    true,
+							ElementType: tftypes.StringType,
+							Description: "Glue IP addresses (IPv4 and/or IPv6) for the nameserver. Required when the nameserver is within the domain itself (e.g., ns1.example.com for example.com).",
+						},
+					},
+				},
+			},
+			"dnssec_keys": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "DNSSEC public keys to publish as DS records in the parent zone, compared as a set. Keys may use different algorithms. When keys are replaced, the new ones are associated before the old ones are removed, so a rotation never leaves the domain without a valid DS record.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"algorithm": schema.Int64Attribute{
+							Required:    true,
+							Description: "DNSSEC algorithm number of the key (e.g., 13 for ECDSAP256SHA256).",
+						},
+						"flags": schema.Int64Attribute{
+							Required:    true,
+							Description: "Key flags: 257 for a key-signing key, 256 for a zone-signing key.",
+						},
+						"public_key": schema.StringAttribute{
+							Required:    true,
+							Description: "Base64-encoded public key, as in the DNSKEY record.",
+						},
+					},
+				},
 			},
 			"allow_delete": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
-				Description: "DANGER: If true, destroying this resource will attempt to delete the domain registration. Default is false (domain is only removed from state).",
+				Description: "DANGER: If true, destroying this resource will attempt to delete the domain registration. Default is false (domain is only removed from state). Every plan warns while it is true.",
+				PlanModifiers: []planmodifier.Bool{
+					allowDeleteWarningModifier{},
+				},
 			},
 			"delete_hosted_zone": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
-				Description: "Delete the auto-created Route53 hosted zone after domain registration. Use when pointing to external DNS. Only deletes if zone is public, has registrar comment, and contains only NS/SOA records.",
+				Description: "Delete the auto-created Route53 hosted zone after domain registration. Use when pointing to external DNS. Only deletes if zone is public, has registrar comment, and contains only NS/SOA records and deletable_zone_records.",
+			},
+			"registrar_zone_comments": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Default:     listdefault.StaticValue(tftypes.ListValueMust(tftypes.StringType, []attr.Value{tftypes.StringValue(registrarZoneComment)})),
+				Description: "Hosted zone comments accepted as marking the zone created by the Route53 Registrar. A zone is only deleted when its comment is one of these and the other safety checks pass.",
+			},
+			"deletable_zone_records": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Records, besides NS and SOA, that do not keep delete_hosted_zone from deleting the registrar-created hosted zone, such as TXT records for domain verification. They are deleted with the zone; any other record still keeps it.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "Record type, such as TXT.",
+							Validators: []validator.String{
+								recordTypeValidator{},
+							},
+						},
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Fully qualified record name, such as _verify.example.com. Matches records of the type at any name when unset.",
+						},
+					},
+				},
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Tags to apply to the registrar-created hosted zone, e.g. for cost allocation. Only applied when manage_hosted_zone is true and the zone is kept. Tags on the zone that are not set here are left alone.",
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "The tags applied to the hosted zone: the provider's default_tags merged with tags, where tags wins on a conflicting key.",
+			},
+			"manage_hosted_zone": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the provider looks up or deletes the registrar-created Route53 hosted zone. Set to false for domains using external DNS to skip all Route53 calls; hosted_zone_id is then null and delete_hosted_zone is ignored.",
 			},
 			"status": schema.StringAttribute{
 				Computed:    true,
@@ -201,15 +469,32 @@ func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.Sc
 				Computed:    true,
 				Description: "Expiration date of the domain registration.",
 			},
+			"days_until_expiry": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Whole days left until expiration_date as of the last refresh, negative once expired. Useful for expiry alerts.",
+			},
 			"creation_date": schema.StringAttribute{
 				Computed:    true,
 				Description: "Creation date of the domain registration.",
 			},
 			"registration_timeout": schema.Int64Attribute{
+				Optional:           true,
+				Computed:           true,
+				Default:            int64default.StaticInt64(900),
+				Description:        "Timeout in seconds to wait for domain registration to complete (default: 900 = 15 minutes). Also bounds the wait for nameserver updates and renewals. Ignored when timeouts.create is set.",
+				DeprecationMessage: "Use timeouts { create = \"15m\" } instead.",
+			},
+			"delete_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultDeleteTimeout),
+				Description: "Timeout in seconds to wait for domain deletion to complete when allow_delete is true (default: 900 = 15 minutes).",
+			},
+			"unlock_before_delete": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(900),
-				Description: "Timeout in seconds to wait for domain registration to complete (default: 900 = 15 minutes).",
+				Default:     booldefault.StaticBool(false),
+				Description: "Remove the domain's transfer lock before deleting it when allow_delete is true. DeleteDomain fails for locked domains.",
 			},
 			"hosted_zone_id": schema.StringAttribute{
 				Computed:    true,
@@ -218,170 +503,1755 @@ func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"registrar_nameservers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: tftypes.StringType,
+				Description: "Nameservers of the registrar-created hosted zone, from its NS records, to configure at an external DNS provider. Null when the zone is not found or manage_hosted_zone is false.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the registration operation while it is still pending after registration_timeout. Cleared once the registration succeeds.",
+			},
+			"reachability_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether the registrant contact has verified their email address: PENDING, DONE, or EXPIRED. Domains stay PENDING or EXPIRED until the ICANN verification email is confirmed and may be suspended.",
+			},
+			"admin_contact_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the admin contact as AWS last reported it. Changes whenever the contact does; with privacy protection on, only its contact type and country code are hashed.",
+			},
+			"registrant_contact_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the registrant contact as AWS last reported it. Changes whenever the contact does; with privacy protection on, only its contact type and country code are hashed.",
+			},
+			"tech_contact_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the tech contact as AWS last reported it. Changes whenever the contact does; with privacy protection on, only its contact type and country code are hashed.",
+			},
+			"whois_privacy_effective": schema.MapAttribute{
+				Computed:    true,
+				ElementType: tftypes.BoolType,
+				Description: "Whether WHOIS privacy is actually in effect, keyed by role (admin, registrant, tech, and billing once billing_contact is set). True only when privacy is enabled and AWS returns the contact redacted; false when the TLD ignored the privacy request and the contact is public.",
+			},
+			"reseller": schema.StringAttribute{
+				Computed:    true,
+				Description: "Reseller of the domain, if any. Domains registered or transferred through Route 53 report Amazon as the reseller.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"whois_server": schema.StringAttribute{
+				Computed:    true,
+				Description: "The WHOIS server that answers queries for the domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registrar_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the domain registrar.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registrar": schema.StringAttribute{
+				Computed:    true,
+				Description: "Registrar of record, from registrar_name: AMAZON for Amazon Registrar, GANDI for the TLDs Route 53 registers through Gandi, or OTHER. Gandi-registered domains do not support deletion for every TLD.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registrar_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Web address of the registrar.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"abuse_contact_email": schema.StringAttribute{
+				Computed:    true,
+				Description: "Email address at the registrar for reporting abuse.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"abuse_contact_phone": schema.StringAttribute{
+				Computed:    true,
+				Description: "Phone number at the registrar for reporting abuse.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resend_reachability_email": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When changed to true, resends the contact verification email to the registrant. Set back to false and then true again to send another.",
+			},
+			"validate_availability": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Check during plan that the domain is available for registration, failing the plan instead of the apply if it is not. Only checked before the domain is created.",
+			},
+			"skip_detail_refresh": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Skip the GetDomainDetail call after registration. Dates and registrar details are left null until the next refresh, saving an API call per domain when registering many.",
+			},
+			"allow_registrant_change": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Allow changes to the registrant contact of a registered domain. For many TLDs such a change starts a change of ownership, which may charge a fee and needs the registrant to confirm by email, so plans changing it fail unless this is true.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schema.SingleNestedBlock{
+				Description: "Operation timeouts.",
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional:    true,
+						Description: "How long to wait for the registration to complete, as a duration such as \"20m\". Also bounds the wait for nameserver updates and renewals. Overrides registration_timeout.",
+						Validators: []validator.String{
+							durationValidator{},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func (r *DomainRegistrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ValidateConfig requires every contact role to be covered, by its own block,
+// its contact template or the shared contact.
+func (r *DomainRegistrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateGlueIPs(ctx, req, resp)
+	validateExtraParams(ctx, req, resp)
+	validateOrganizationNames(ctx, req, resp)
+	validateZipCodes(ctx, req, resp)
+	validateZoneTags(ctx, req, resp)
+
+	var shared tftypes.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("contact"), &shared)...)
+	if resp.Diagnostics.HasError() || !shared.IsNull() {
 		return
 	}
 
-	providerData, ok := req.ProviderData.(*ProviderData)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
-		)
-		return
+	for _, role := range []string{"admin_contact", "registrant_contact", "tech_contact"} {
+		var contact tftypes.Object
+		var template tftypes.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(role), &contact)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(role+"_template"), &template)...)
+		if contact.IsNull() && template.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(role),
+				"Missing contact",
+				fmt.Sprintf("%s or %s_template must be set when the shared contact is not set.", role, role),
+			)
+		}
 	}
-
-	r.client = providerData.DomainsClient
-	r.route53Client = providerData.Route53Client
 }
 
-func contactModelToAWS(m *ContactModel) *types.ContactDetail {
-	if m == nil {
-		return nil
+// validateGlueIPs requires glue IPs for in-bailiwick nameservers, those named
+// within the domain itself, as the registry cannot resolve them otherwise.
+func validateGlueIPs(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var domainName tftypes.String
+	var nameservers tftypes.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domain_name"), &domainName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("nameservers"), &nameservers)...)
+	if resp.Diagnostics.HasError() || domainName.IsUnknown() || nameservers.IsNull() || nameservers.IsUnknown() {
+		return
 	}
 
-	contact := &types.ContactDetail{
-		FirstName:    aws.String(m.FirstName.ValueString()),
-		LastName:     aws.String(m.LastName.ValueString()),
-		Email:        aws.String(m.Email.ValueString()),
-		PhoneNumber:  aws.String(m.PhoneNumber.ValueString()),
-		AddressLine1: aws.String(m.AddressLine1.ValueString()),
-		City:         aws.String(m.City.ValueString()),
-		State:        aws.String(m.State.ValueString()),
-		ZipCode:      aws.String(m.ZipCode.ValueString()),
-		CountryCode:  types.CountryCode(m.CountryCode.ValueString()),
-	}
+	domain := canonicalDomainName(domainName.ValueString())
+	for i := range nameservers.Elements() {
+		var name tftypes.String
+		var glueIPs tftypes.List
+		nsPath := path.Root("nameservers").AtListIndex(i)
+		diags := req.Config.GetAttribute(ctx, nsPath.AtName("name"), &name)
+		diags.Append(req.Config.GetAttribute(ctx, nsPath.AtName("glue_ips"), &glueIPs)...)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if name.IsNull() || name.IsUnknown() || glueIPs.IsUnknown() || len(glueIPs.Elements()) > 0 {
+			continue
+		}
 
-	if !m.AddressLine2.IsNull() && !m.AddressLine2.IsUnknown() {
-		contact.AddressLine2 = aws.String(m.AddressLine2.ValueString())
+		host := strings.TrimSuffix(canonicalDomainName(name.ValueString()), ".")
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			resp.Diagnostics.AddAttributeError(
+				nsPath.AtName("glue_ips"),
+				"Missing glue IPs",
+				fmt.Sprintf("Nameserver %s is within %s, so glue_ips must be set for it to be resolvable.", name.ValueString(), domain),
+			)
+		}
 	}
+}
 
-	if !m.ContactType.IsNull() && !m.ContactType.IsUnknown() {
-		contact.ContactType = types.ContactType(m.ContactType.ValueString())
-	} else {
-		contact.ContactType = types.ContactTypePerson
-	}
+// validateOrganizationNames requires organization_name on every COMPANY or
+// ASSOCIATION contact, which AWS otherwise rejects only once the registration
+// or contact update is submitted.
+func validateOrganizationNames(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, block := range []string{"contact", "admin_contact", "registrant_contact", "tech_contact", "billing_contact"} {
+		var contactType, organization tftypes.String
+		diags := req.Config.GetAttribute(ctx, path.Root(block).AtName("contact_type"), &contactType)
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(block).AtName("organization_name"), &organization)...)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if organization.IsUnknown() || organization.ValueString() != "" {
+			continue
+		}
 
-	return contact
+		switch types.ContactType(contactType.ValueString()) {
+		case types.ContactTypeCompany, types.ContactTypeAssociation:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block).AtName("organization_name"),
+				"Missing organization name",
+				fmt.Sprintf("%s.organization_name must be set when contact_type is %s.", block, contactType.ValueString()),
+			)
+		}
+	}
 }
 
-// findHostedZoneID looks up the Route53 hosted zone ID for a domain
-func (r *DomainRegistrationResource) findHostedZoneID(ctx context.Context, domainName string) (string, error) {
-	input := &route53.ListHostedZonesByNameInput{
-		DNSName:  aws.String(domainName),
-		MaxItems: aws.Int32(1),
-	}
+// validateZipCodes warns when a contact's zip_code does not match the postal
+// code format of its country_code in zipCodeFormats. It is only a warning, as
+// the formats are best effort and some registries accept codes they do not
+// cover.
+func validateZipCodes(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, block := range []string{"contact", "admin_contact", "registrant_contact", "tech_contact", "billing_contact"} {
+		var zipCode, countryCode tftypes.String
+		diags := req.Config.GetAttribute(ctx, path.Root(block).AtName("zip_code"), &zipCode)
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(block).AtName("country_code"), &countryCode)...)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if zipCode.IsNull() || zipCode.IsUnknown() || countryCode.IsUnknown() {
+			continue
+		}
 
-	output, err := r.route53Client.ListHostedZonesByName(ctx, input)
-	if err != nil {
-		return "", fmt.Errorf("failed to list hosted zones: %w", err)
+		country := strings.ToUpper(countryCode.ValueString())
+		format, ok := zipCodeFormats[country]
+		if !ok || format.pattern.MatchString(strings.TrimSpace(zipCode.ValueString())) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(block).AtName("zip_code"),
+			"Unexpected zip code format",
+			fmt.Sprintf("%s.zip_code %q does not look like a postal code of %s, such as %s. AWS may reject the contact; if the code is correct, ignore this warning.", block, zipCode.ValueString(), country, format.example),
+		)
 	}
+}
 
-	// Find exact match (AWS returns zones starting with the name)
-	for _, zone := range output.HostedZones {
-		// Zone names have trailing dot, domain names don't
-		zoneName := strings.TrimSuffix(aws.ToString(zone.Name), ".")
-		if zoneName == domainName {
-			// Zone ID format is "/hostedzone/Z1234567890ABC" - extract just the ID
-			zoneID := strings.TrimPrefix(aws.ToString(zone.Id), "/hostedzone/")
-			return zoneID, nil
-		}
+// validateZoneTags warns when tags are set but the hosted zone they would be
+// applied to is not managed or is deleted after registration.
+func validateZoneTags(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tags tftypes.Map
+	var manage, deleteZone tftypes.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manage_hosted_zone"), &manage)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("delete_hosted_zone"), &deleteZone)...)
+	if resp.Diagnostics.HasError() || tags.IsNull() || tags.IsUnknown() || len(tags.Elements()) == 0 {
+		return
 	}
 
-	return "", fmt.Errorf("hosted zone not found for domain %s", domainName)
+	reason := ""
+	switch {
+	case !manage.IsNull() && !manage.IsUnknown() && !manage.ValueBool():
+		reason = "manage_hosted_zone is false"
+	case deleteZone.ValueBool():
+		reason = "delete_hosted_zone deletes the zone"
+	default:
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("tags"),
+		"Tags will not be applied",
+		fmt.Sprintf("tags are only applied to the registrar hosted zone, but %s.", reason),
+	)
 }
 
-// deleteRegistrarHostedZone safely deletes the hosted zone only if ALL conditions are met:
-// 1. Zone name matches the domain exactly
-// 2. Zone is public (not private)
-// 3. Zone comment is "HostedZone created by Route53 Registrar"
-// 4. Zone contains only NS and SOA records (no custom records)
-func (r *DomainRegistrationResource) deleteRegistrarHostedZone(ctx context.Context, domainName string) error {
-	input := &route53.ListHostedZonesByNameInput{
-		DNSName:  aws.String(domainName),
-		MaxItems: aws.Int32(1),
+// ModifyPlan checks that a domain about to be registered is available when
+// validate_availability is set, so an unavailable domain fails the plan rather
+// than partway through an apply.
+func (r *DomainRegistrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		warnRegistrarDeletion(ctx, req, resp)
+		return
+	}
+	r.planTagsAll(ctx, req, resp)
+	r.planContactTemplates(ctx, req, resp)
+	if !req.State.Raw.IsNull() {
+		r.checkRegistrantChange(ctx, req, resp)
+		return
+	}
+	if r.client == nil {
+		return
 	}
 
-	output, err := r.route53Client.ListHostedZonesByName(ctx, input)
-	if err != nil {
-		return fmt.Errorf("failed to list hosted zones: %w", err)
+	var validate tftypes.Bool
+	var domainName tftypes.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("validate_availability"), &validate)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain_name"), &domainName)...)
+	if resp.Diagnostics.HasError() || !validate.ValueBool() || domainName.IsUnknown() {
+		return
 	}
 
-	// Find exact match
-	for _, zone := range output.HostedZones {
-		zoneName := strings.TrimSuffix(aws.ToString(zone.Name), ".")
-		if zoneName != domainName {
-			continue
+	output, err := r.client.CheckDomainAvailability(ctx, &route53domains.CheckDomainAvailabilityInput{
+		DomainName: aws.String(canonicalDomainName(domainName.ValueString())),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error checking domain availability",
+			fmt.Sprintf("Could not check availability for %s: %s", domainName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if !domainAvailable(output.Availability) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain_name"),
+			"Domain not available",
+			fmt.Sprintf("%s cannot be registered: its availability is %s.", domainName.ValueString(), output.Availability),
+		)
+	}
+}
+
+// warnRegistrarDeletion warns when a destroy plan will delete a domain whose
+// registrar is Gandi, as Gandi does not support deletion for every TLD it
+// registers.
+func warnRegistrarDeletion(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var allowDelete tftypes.Bool
+	var registrar, domainName tftypes.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("allow_delete"), &allowDelete)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("registrar"), &registrar)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("domain_name"), &domainName)...)
+	if resp.Diagnostics.HasError() || !allowDelete.ValueBool() || registrar.ValueString() != registrarGandi {
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"Registrar may not support deletion",
+		fmt.Sprintf("%s is registered through Gandi, which does not support deleting domains of every TLD. If AWS rejects the deletion, the domain is removed from Terraform state but stays registered until it expires; set auto_renew = false first so it is not renewed.", domainName.ValueString()),
+	)
+}
+
+// planTagsAll plans tags_all from tags and the provider's default tags, so a
+// change to either shows in the plan. It is unknown while tags is.
+func (r *DomainRegistrationResource) planTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var tags tftypes.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tagsAll := tftypes.MapUnknown(tftypes.StringType)
+	if !tags.IsUnknown() {
+		configured := make(map[string]tftypes.String, len(tags.Elements()))
+		resp.Diagnostics.Append(tags.ElementsAs(ctx, &configured, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tagsAll = tagsAllValue(mergeTags(r.defaultTags, configured))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// planContactTemplates checks that the contact templates the plan names are
+// defined by the provider, and plans contact_template_hashes from them so a
+// change to a template shows in the plan.
+func (r *DomainRegistrationResource) planContactTemplates(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	names := make(map[string]tftypes.String, 3)
+	overridden := make(map[string]bool, 3)
+	for _, role := range []string{"admin", "registrant", "tech"} {
+		var name tftypes.String
+		var contact tftypes.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(role+"_contact_template"), &name)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(role+"_contact"), &contact)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !name.IsNull() && !name.IsUnknown() && r.contactTemplate(name) == nil {
+			defined := "It defines none."
+			if len(r.contactTemplates) > 0 {
+				defined = "It defines " + strings.Join(slices.Sorted(maps.Keys(r.contactTemplates)), ", ") + "."
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(role+"_contact_template"),
+				"Unknown contact template",
+				fmt.Sprintf("The provider has no contact template named %q. %s", name.ValueString(), defined),
+			)
+		}
+		if contact.IsUnknown() && !name.IsNull() {
+			// Whether the template is overridden is only known at apply
+			name = tftypes.StringUnknown()
+		}
+		names[role] = name
+		overridden[role] = !contact.IsNull()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("contact_template_hashes"), r.templateHashes(names, overridden))...)
+}
+
+// mergeTags returns the default tags overlaid with tags, so a resource tag
+// wins over a default tag with the same key.
+func mergeTags(defaults map[string]string, tags map[string]tftypes.String) map[string]tftypes.String {
+	merged := make(map[string]tftypes.String, len(defaults)+len(tags))
+	for key, value := range defaults {
+		merged[key] = tftypes.StringValue(value)
+	}
+	maps.Copy(merged, tags)
+	return merged
+}
+
+// tagsAllValue returns tags as a tags_all value, null when there are none.
+func tagsAllValue(tags map[string]tftypes.String) tftypes.Map {
+	if len(tags) == 0 {
+		return tftypes.MapNull(tftypes.StringType)
+	}
+	elements := make(map[string]attr.Value, len(tags))
+	for key, value := range tags {
+		elements[key] = value
+	}
+	return tftypes.MapValueMust(tftypes.StringType, elements)
+}
+
+// tagsAllMap returns the tags of a tags_all value, nil when it is null or
+// unknown.
+func tagsAllMap(tagsAll tftypes.Map) map[string]tftypes.String {
+	if tagsAll.IsNull() || tagsAll.IsUnknown() {
+		return nil
+	}
+	tags := make(map[string]tftypes.String, len(tagsAll.Elements()))
+	for key, value := range tagsAll.Elements() {
+		if v, ok := value.(tftypes.String); ok {
+			tags[key] = v
+		}
+	}
+	return tags
+}
+
+// attributeGetter is satisfied by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// registrantContact returns the registrant contact in effect, registrant_contact,
+// else the registrant_contact_template in templates, else the shared contact,
+// with the path it is configured at. known is false while any of them is
+// unknown, and for a template not in templates, as templates are only known
+// once the provider is configured.
+func registrantContact(ctx context.Context, data attributeGetter, templates map[string]ContactModel) (contact *ContactModel, at path.Path, known bool, diags diag.Diagnostics) {
+	for _, name := range []string{"registrant_contact", "registrant_contact_template", "contact"} {
+		if name == "registrant_contact_template" {
+			var templateName tftypes.String
+			diags.Append(data.GetAttribute(ctx, path.Root(name), &templateName)...)
+			if templateName.IsNull() {
+				continue
+			}
+			template, ok := templates[templateName.ValueString()]
+			if diags.HasError() || templateName.IsUnknown() || !ok {
+				return nil, path.Root(name), false, diags
+			}
+			return &template, path.Root(name), contactKnown(&template), diags
+		}
+
+		var obj tftypes.Object
+		diags.Append(data.GetAttribute(ctx, path.Root(name), &obj)...)
+		if diags.HasError() || obj.IsUnknown() {
+			return nil, path.Root(name), false, diags
+		}
+		if obj.IsNull() {
+			continue
+		}
+		if params, ok := obj.Attributes()["extra_params"]; ok && params.IsUnknown() {
+			// An unknown map cannot be read into the model
+			return nil, path.Root(name), false, diags
+		}
+		contact = &ContactModel{}
+		diags.Append(obj.As(ctx, contact, basetypes.ObjectAsOptions{})...)
+		return contact, path.Root(name), !diags.HasError(), diags
+	}
+	return nil, path.Root("registrant_contact"), true, diags
+}
+
+// checkRegistrantChange fails plans that change the registrant contact of a
+// registered domain unless allow_registrant_change is set, and warns about
+// the ownership change when it is. A registrant missing from state, as after
+// importing a privacy-protected domain, is not treated as a change. It runs
+// after planContactTemplates, so a changed registrant template shows in the
+// planned contact_template_hashes.
+func (r *DomainRegistrationResource) checkRegistrantChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	prior, _, _, diags := registrantContact(ctx, req.State, r.contactTemplates)
+	resp.Diagnostics.Append(diags...)
+	planned, at, known, diags := registrantContact(ctx, req.Plan, r.contactTemplates)
+	resp.Diagnostics.Append(diags...)
+	var plannedHashes, priorHashes tftypes.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("contact_template_hashes"), &plannedHashes)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("contact_template_hashes"), &priorHashes)...)
+	if resp.Diagnostics.HasError() || prior == nil || planned == nil {
+		return
+	}
+	if known && contactsEqual(prior, planned) && !templateHashChanged(plannedHashes, priorHashes, "registrant") {
+		return
+	}
+
+	var allow tftypes.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_registrant_change"), &allow)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !allow.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			at,
+			"Registrant change not allowed",
+			"This plan changes the registrant contact. For many TLDs that starts a change of ownership, which may charge a fee and must be confirmed by the registrant by email. "+
+				"Set allow_registrant_change = true to apply it.",
+		)
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		at,
+		"Registrant change may start an ownership change",
+		"This plan changes the registrant contact. For many TLDs the registry treats this as a change of ownership, which may charge a fee and is only completed once the registrant confirms it by email.",
+	)
+}
+
+func (r *DomainRegistrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.DomainsClient
+	r.route53Client = providerData.Route53Client
+	r.defaultTags = providerData.DefaultTags
+	r.contactTemplates = providerData.ContactTemplates
+}
+
+func contactModelToAWS(m *ContactModel) *types.ContactDetail {
+	if m == nil {
+		return nil
+	}
+
+	contact := &types.ContactDetail{
+		FirstName:    aws.String(m.FirstName.ValueString()),
+		LastName:     aws.String(m.LastName.ValueString()),
+		Email:        aws.String(m.Email.ValueString()),
+		PhoneNumber:  aws.String(m.PhoneNumber.ValueString()),
+		AddressLine1: aws.String(m.AddressLine1.ValueString()),
+		City:         aws.String(m.City.ValueString()),
+		State:        aws.String(m.State.ValueString()),
+		ZipCode:      aws.String(m.ZipCode.ValueString()),
+		CountryCode:  types.CountryCode(m.CountryCode.ValueString()),
+	}
+
+	if !m.AddressLine2.IsNull() && !m.AddressLine2.IsUnknown() {
+		contact.AddressLine2 = aws.String(m.AddressLine2.ValueString())
+	}
+
+	if !m.Organization.IsNull() && !m.Organization.IsUnknown() {
+		contact.OrganizationName = aws.String(m.Organization.ValueString())
+	}
+
+	if !m.ContactType.IsNull() && !m.ContactType.IsUnknown() {
+		contact.ContactType = types.ContactType(m.ContactType.ValueString())
+	} else {
+		contact.ContactType = types.ContactTypePerson
+	}
+
+	// Sorted so the request is the same on every apply
+	for _, name := range slices.Sorted(maps.Keys(m.ExtraParams)) {
+		contact.ExtraParams = append(contact.ExtraParams, types.ExtraParam{
+			Name:  types.ExtraParamName(name),
+			Value: aws.String(m.ExtraParams[name].ValueString()),
+		})
+	}
+
+	return contact
+}
+
+// roleContact returns the first of contacts that is set, the contacts a role
+// falls back through in order.
+func roleContact(contacts ...*ContactModel) *ContactModel {
+	for _, c := range contacts {
+		if c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// roleContacts returns the admin, registrant and tech contacts in effect for
+// data: each role's own block, else its contact template, else the shared
+// contact.
+func (r *DomainRegistrationResource) roleContacts(data *DomainRegistrationResourceModel) (admin, registrant, tech *ContactModel) {
+	return roleContact(data.AdminContact, r.contactTemplate(data.AdminContactTemplate), data.Contact),
+		roleContact(data.RegistrantContact, r.contactTemplate(data.RegistrantContactTemplate), data.Contact),
+		roleContact(data.TechContact, r.contactTemplate(data.TechContactTemplate), data.Contact)
+}
+
+// contactTemplate returns the provider's contact template named name, or nil
+// when name is null or unknown or no template has it.
+func (r *DomainRegistrationResource) contactTemplate(name tftypes.String) *ContactModel {
+	if name.IsNull() || name.IsUnknown() {
+		return nil
+	}
+	template, ok := r.contactTemplates[name.ValueString()]
+	if !ok {
+		return nil
+	}
+	return &template
+}
+
+// contactTemplateHashes returns contact_template_hashes for data.
+func (r *DomainRegistrationResource) contactTemplateHashes(data *DomainRegistrationResourceModel) tftypes.Map {
+	return r.templateHashes(
+		map[string]tftypes.String{
+			"admin":      data.AdminContactTemplate,
+			"registrant": data.RegistrantContactTemplate,
+			"tech":       data.TechContactTemplate,
+		},
+		map[string]bool{
+			"admin":      data.AdminContact != nil,
+			"registrant": data.RegistrantContact != nil,
+			"tech":       data.TechContact != nil,
+		},
+	)
+}
+
+// templateHashes hashes the contact template named for each role, skipping
+// roles whose own block is set and templates that do not exist. The result is
+// unknown while a name or any value of a named template is, and null when no
+// role uses a template.
+func (r *DomainRegistrationResource) templateHashes(names map[string]tftypes.String, overridden map[string]bool) tftypes.Map {
+	hashes := make(map[string]attr.Value, len(names))
+	for role, name := range names {
+		if name.IsUnknown() {
+			return tftypes.MapUnknown(tftypes.StringType)
+		}
+		template := r.contactTemplate(name)
+		if template == nil || overridden[role] {
+			continue
+		}
+		if !contactKnown(template) {
+			return tftypes.MapUnknown(tftypes.StringType)
+		}
+		hashes[role] = contactHash(contactModelToAWS(template), false)
+	}
+	if len(hashes) == 0 {
+		return tftypes.MapNull(tftypes.StringType)
+	}
+	return tftypes.MapValueMust(tftypes.StringType, hashes)
+}
+
+// templateHashChanged reports whether the template hash of role differs
+// between two contact_template_hashes values. A role that starts or stops
+// using a template is not a change here, as the contact it resolves to is
+// compared instead.
+func templateHashChanged(planned, prior tftypes.Map, role string) bool {
+	p, q := planned.Elements()[role], prior.Elements()[role]
+	return p != nil && q != nil && !p.Equal(q)
+}
+
+// contactKnown reports whether every value of c is known, as those of a
+// provider contact template need not be until apply.
+func contactKnown(c *ContactModel) bool {
+	for _, v := range []tftypes.String{c.FirstName, c.LastName, c.Organization, c.Email, c.PhoneNumber, c.AddressLine1, c.AddressLine2, c.City, c.State, c.ZipCode, c.CountryCode, c.ContactType} {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	for _, v := range c.ExtraParams {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// contactFromAWS converts a contact returned by GetDomainDetail into the
+// model. When the contact is privacy protected AWS returns redacted values
+// (e.g. a proxy email), so the prior value is kept to avoid false drift. With
+// no prior value, as after an import, a protected contact is left unset rather
+// than filled with the redacted values.
+func contactFromAWS(prior *ContactModel, c *types.ContactDetail, privacy bool) *ContactModel {
+	if c == nil || privacy {
+		return prior
+	}
+
+	m := &ContactModel{
+		FirstName:    tftypes.StringPointerValue(c.FirstName),
+		LastName:     tftypes.StringPointerValue(c.LastName),
+		Organization: tftypes.StringPointerValue(c.OrganizationName),
+		Email:        tftypes.StringPointerValue(c.Email),
+		PhoneNumber:  tftypes.StringPointerValue(c.PhoneNumber),
+		AddressLine1: tftypes.StringPointerValue(c.AddressLine1),
+		AddressLine2: tftypes.StringPointerValue(c.AddressLine2),
+		City:         tftypes.StringPointerValue(c.City),
+		State:        tftypes.StringPointerValue(c.State),
+		ZipCode:      tftypes.StringPointerValue(c.ZipCode),
+		CountryCode:  tftypes.StringNull(),
+		ContactType:  tftypes.StringNull(),
+	}
+	if c.CountryCode != "" {
+		m.CountryCode = tftypes.StringValue(string(c.CountryCode))
+	}
+
+	// contact_type is optional and defaults to PERSON, so leave it null unless
+	// it was configured or AWS reports something else
+	explicitType := prior != nil && !prior.ContactType.IsNull()
+	if c.ContactType != "" && (explicitType || c.ContactType != types.ContactTypePerson) {
+		m.ContactType = tftypes.StringValue(string(c.ContactType))
+	}
+
+	// extra_params is only refreshed once configured, as registries may
+	// report params of their own
+	if prior != nil && prior.ExtraParams != nil {
+		m.ExtraParams = extraParamsFromAWS(c.ExtraParams)
+	}
+
+	return m
+}
+
+func extraParamsFromAWS(params []types.ExtraParam) map[string]tftypes.String {
+	m := make(map[string]tftypes.String, len(params))
+	for _, p := range params {
+		m[string(p.Name)] = tftypes.StringPointerValue(p.Value)
+	}
+	return m
+}
+
+// canonicalDomainName returns the lowercase ASCII (punycode) form of a domain
+// name, which is what GetDomainDetail and Route53 report for IDNs. The API
+// calls and the resource ID use it so "café.com" and "xn--caf-dma.com" are
+// the same domain. Names that cannot be converted are only lowercased, leaving
+// AWS to reject them.
+func canonicalDomainName(name string) string {
+	ascii, err := idna.Lookup.ToASCII(name)
+	if err != nil {
+		return strings.ToLower(name)
+	}
+	return ascii
+}
+
+// domainNameChanged only requires replacement when domain_name names a
+// different domain, not when an imported punycode name is configured in
+// Unicode or vice versa.
+func domainNameChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = canonicalDomainName(req.StateValue.ValueString()) != canonicalDomainName(req.PlanValue.ValueString())
+}
+
+// daysUntilExpiry returns the whole days from now until expiry, rounded down
+// and negative once the domain has expired, or null when AWS reports no
+// expiration date.
+func daysUntilExpiry(expiry *time.Time, now time.Time) tftypes.Int64 {
+	if expiry == nil {
+		return tftypes.Int64Null()
+	}
+	return tftypes.Int64Value(int64(math.Floor(expiry.Sub(now).Hours() / 24)))
+}
+
+// formatTimestamp formats an AWS timestamp for state as RFC3339 in UTC, so
+// the same instant is stored identically whatever location the SDK decoded it
+// in, and Create, Read and Update never disagree over its offset.
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// setDomainDates copies the expiration and creation dates from a
+// GetDomainDetail response. A date missing from the response keeps its prior
+// value.
+func setDomainDates(data *DomainRegistrationResourceModel, detail *route53domains.GetDomainDetailOutput) {
+	if detail.ExpirationDate != nil {
+		data.ExpirationDate = tftypes.StringValue(formatTimestamp(*detail.ExpirationDate))
+	}
+	data.DaysUntilExpiry = daysUntilExpiry(detail.ExpirationDate, time.Now())
+	if detail.CreationDate != nil {
+		data.CreationDate = tftypes.StringValue(formatTimestamp(*detail.CreationDate))
+	}
+}
+
+// setRegistrarInfo copies the read-only registrar and WHOIS details from a
+// GetDomainDetail response, or clears them when detail is nil.
+func setRegistrarInfo(data *DomainRegistrationResourceModel, detail *route53domains.GetDomainDetailOutput) {
+	if detail == nil {
+		detail = &route53domains.GetDomainDetailOutput{}
+	}
+	data.Reseller = tftypes.StringPointerValue(detail.Reseller)
+	data.WhoIsServer = tftypes.StringPointerValue(detail.WhoIsServer)
+	data.RegistrarName = tftypes.StringPointerValue(detail.RegistrarName)
+	data.Registrar = registrarOf(detail.RegistrarName)
+	data.RegistrarURL = tftypes.StringPointerValue(detail.RegistrarUrl)
+	data.AbuseContactEmail = tftypes.StringPointerValue(detail.AbuseContactEmail)
+	data.AbuseContactPhone = tftypes.StringPointerValue(detail.AbuseContactPhone)
+}
+
+// Registrars of record reported in the registrar attribute. Route 53 registers
+// each TLD through either Amazon Registrar or Gandi.
+const (
+	registrarAmazon = "AMAZON"
+	registrarGandi  = "GANDI"
+	registrarOther  = "OTHER"
+)
+
+// registrarOf classifies a GetDomainDetail RegistrarName, null when AWS did
+// not report one.
+func registrarOf(name *string) tftypes.String {
+	if name == nil {
+		return tftypes.StringNull()
+	}
+	switch lower := strings.ToLower(*name); {
+	case strings.Contains(lower, "amazon"):
+		return tftypes.StringValue(registrarAmazon)
+	case strings.Contains(lower, "gandi"):
+		return tftypes.StringValue(registrarGandi)
+	default:
+		return tftypes.StringValue(registrarOther)
+	}
+}
+
+// setContactHashes sets the contact hashes from a GetDomainDetail response,
+// or clears them when detail is nil.
+func setContactHashes(data *DomainRegistrationResourceModel, detail *route53domains.GetDomainDetailOutput) {
+	if detail == nil {
+		detail = &route53domains.GetDomainDetailOutput{}
+	}
+	data.AdminContactHash = contactHash(detail.AdminContact, aws.ToBool(detail.AdminPrivacy))
+	data.RegistrantContactHash = contactHash(detail.RegistrantContact, aws.ToBool(detail.RegistrantPrivacy))
+	data.TechContactHash = contactHash(detail.TechContact, aws.ToBool(detail.TechPrivacy))
+}
+
+// setPrivacyEffective sets whois_privacy_effective from a GetDomainDetail
+// response, or clears it when detail is nil.
+func (r *DomainRegistrationResource) setPrivacyEffective(data *DomainRegistrationResourceModel, detail *route53domains.GetDomainDetailOutput) {
+	if detail == nil {
+		data.WhoisPrivacyEffective = tftypes.MapNull(tftypes.BoolType)
+		return
+	}
+
+	admin, registrant, tech := r.roleContacts(data)
+	effective := map[string]attr.Value{
+		"admin":      privacyEffective(admin, detail.AdminContact, aws.ToBool(detail.AdminPrivacy)),
+		"registrant": privacyEffective(registrant, detail.RegistrantContact, aws.ToBool(detail.RegistrantPrivacy)),
+		"tech":       privacyEffective(tech, detail.TechContact, aws.ToBool(detail.TechPrivacy)),
+	}
+	if data.BillingContact != nil {
+		effective["billing"] = privacyEffective(data.BillingContact, detail.BillingContact, aws.ToBool(detail.BillingPrivacy))
+	}
+	data.WhoisPrivacyEffective = tftypes.MapValueMust(tftypes.BoolType, effective)
+}
+
+// privacyEffective reports whether WHOIS privacy is in effect for a contact:
+// privacy is enabled and AWS returned the contact redacted, its personal
+// fields replaced by placeholders. Some TLDs accept the privacy flag but
+// ignore it, and the contact then comes back exactly as configured. Without a
+// configured contact to compare with, as after an import, the flag is trusted.
+func privacyEffective(configured *ContactModel, c *types.ContactDetail, privacy bool) tftypes.Bool {
+	if !privacy || c == nil {
+		return tftypes.BoolValue(false)
+	}
+	if configured == nil {
+		return tftypes.BoolValue(true)
+	}
+
+	unredacted := strings.EqualFold(aws.ToString(c.Email), configured.Email.ValueString()) &&
+		aws.ToString(c.FirstName) == configured.FirstName.ValueString() &&
+		aws.ToString(c.LastName) == configured.LastName.ValueString() &&
+		aws.ToString(c.PhoneNumber) == configured.PhoneNumber.ValueString() &&
+		aws.ToString(c.AddressLine1) == configured.AddressLine1.ValueString()
+	return tftypes.BoolValue(!unredacted)
+}
+
+// contactHash returns a hex SHA-256 hash of a contact as AWS reports it, or
+// null when there is none. Privacy protection replaces the personal fields
+// with placeholders that may change between reads, so only the contact type
+// and country code are hashed for a privacy-protected contact.
+func contactHash(c *types.ContactDetail, privacy bool) tftypes.String {
+	if c == nil {
+		return tftypes.StringNull()
+	}
+
+	fields := []string{"contact_type", string(c.ContactType), "country_code", string(c.CountryCode)}
+	if privacy {
+		fields = append(fields, "privacy", "true")
+	} else {
+		fields = append(fields,
+			"first_name", aws.ToString(c.FirstName),
+			"last_name", aws.ToString(c.LastName),
+			"organization_name", aws.ToString(c.OrganizationName),
+			"email", aws.ToString(c.Email),
+			"phone_number", aws.ToString(c.PhoneNumber),
+			"fax", aws.ToString(c.Fax),
+			"address_line_1", aws.ToString(c.AddressLine1),
+			"address_line_2", aws.ToString(c.AddressLine2),
+			"city", aws.ToString(c.City),
+			"state", aws.ToString(c.State),
+			"zip_code", aws.ToString(c.ZipCode),
+		)
+		params := slices.Clone(c.ExtraParams)
+		slices.SortFunc(params, func(a, b types.ExtraParam) int { return strings.Compare(string(a.Name), string(b.Name)) })
+		for _, p := range params {
+			fields = append(fields, "extra_param."+string(p.Name), aws.ToString(p.Value))
+		}
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x00")))
+	return tftypes.StringValue(hex.EncodeToString(sum[:]))
+}
+
+// contactsEqual reports whether two contacts hold the same values.
+func contactsEqual(a, b *ContactModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.FirstName.Equal(b.FirstName) &&
+		a.LastName.Equal(b.LastName) &&
+		a.Organization.Equal(b.Organization) &&
+		a.Email.Equal(b.Email) &&
+		a.PhoneNumber.Equal(b.PhoneNumber) &&
+		a.AddressLine1.Equal(b.AddressLine1) &&
+		a.AddressLine2.Equal(b.AddressLine2) &&
+		a.City.Equal(b.City) &&
+		a.State.Equal(b.State) &&
+		a.ZipCode.Equal(b.ZipCode) &&
+		a.CountryCode.Equal(b.CountryCode) &&
+		a.ContactType.Equal(b.ContactType) &&
+		maps.EqualFunc(a.ExtraParams, b.ExtraParams, func(x, y tftypes.String) bool { return x.Equal(y) })
+}
+
+// nameserversEqual reports whether two nameserver lists name the same set of
+// nameservers with the same glue IPs, ignoring order, case and duplicates.
+func nameserversEqual(a, b []NameserverModel) bool {
+	a, b = normalizeNameservers(a), normalizeNameservers(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if nameserverKey(a[i].Name.ValueString()) != nameserverKey(b[i].Name.ValueString()) ||
+			!slices.Equal(sortedGlueIPs(a[i]), sortedGlueIPs(b[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeNameservers drops repeated nameservers, compared case-insensitively
+// and keeping the first, and sorts the rest by name. AWS stores nameservers as
+// a set, so sending them this way keeps what it reports back stable.
+func normalizeNameservers(m []NameserverModel) []NameserverModel {
+	seen := make(map[string]bool, len(m))
+	var out []NameserverModel
+	for _, ns := range m {
+		key := nameserverKey(ns.Name.ValueString())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, ns)
+	}
+	slices.SortFunc(out, func(a, b NameserverModel) int {
+		return strings.Compare(nameserverKey(a.Name.ValueString()), nameserverKey(b.Name.ValueString()))
+	})
+	return out
+}
+
+// nameserverKey is the case-insensitive identity of a nameserver name.
+func nameserverKey(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// sortedGlueIPs returns a nameserver's glue IPs as a sorted set, each in its
+// canonical form so that AWS reporting 2001:DB8:0::1 as 2001:db8::1 does not
+// count as a change. Values that do not parse are kept as written.
+func sortedGlueIPs(ns NameserverModel) []string {
+	ips := make([]string, 0, len(ns.GlueIPs))
+	for _, ip := range ns.GlueIPs {
+		if addr, err := netip.ParseAddr(ip.ValueString()); err == nil {
+			ips = append(ips, addr.String())
+			continue
+		}
+		ips = append(ips, ip.ValueString())
+	}
+	slices.Sort(ips)
+	return slices.Compact(ips)
+}
+
+func nameserversToAWS(m []NameserverModel) []types.Nameserver {
+	var nameservers []types.Nameserver
+	for _, ns := range m {
+		nameserver := types.Nameserver{
+			Name: aws.String(ns.Name.ValueString()),
+		}
+		for _, ip := range ns.GlueIPs {
+			nameserver.GlueIps = append(nameserver.GlueIps, ip.ValueString())
+		}
+		nameservers = append(nameservers, nameserver)
+	}
+	return nameservers
+}
+
+func nameserversFromAWS(nameservers []types.Nameserver) []NameserverModel {
+	var m []NameserverModel
+	for _, ns := range nameservers {
+		nameserver := NameserverModel{
+			Name: tftypes.StringValue(aws.ToString(ns.Name)),
+		}
+		for _, ip := range ns.GlueIps {
+			nameserver.GlueIPs = append(nameserver.GlueIPs, tftypes.StringValue(ip))
+		}
+		m = append(m, nameserver)
+	}
+	return m
+}
+
+// dnssecKeyID is the identity of a DNSSEC key: its algorithm, flags and
+// public key, ignoring whitespace in the key.
+func dnssecKeyID(algorithm, flags int64, publicKey string) string {
+	return fmt.Sprintf("%d/%d/%s", algorithm, flags, strings.Join(strings.Fields(publicKey), ""))
+}
+
+func dnssecKeyModelID(k DnssecKeyModel) string {
+	return dnssecKeyID(k.Algorithm.ValueInt64(), k.Flags.ValueInt64(), k.PublicKey.ValueString())
+}
+
+// dnssecKeysMissing returns the keys of a not found in b, without repeats.
+func dnssecKeysMissing(a, b []DnssecKeyModel) []DnssecKeyModel {
+	seen := make(map[string]bool, len(a)+len(b))
+	for _, k := range b {
+		seen[dnssecKeyModelID(k)] = true
+	}
+	var out []DnssecKeyModel
+	for _, k := range a {
+		if id := dnssecKeyModelID(k); !seen[id] {
+			seen[id] = true
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// dnssecKeysEqual reports whether two key lists hold the same set of keys,
+// ignoring order and duplicates.
+func dnssecKeysEqual(a, b []DnssecKeyModel) bool {
+	return len(dnssecKeysMissing(a, b)) == 0 && len(dnssecKeysMissing(b, a)) == 0
+}
+
+func dnssecKeysFromAWS(keys []types.DnssecKey) []DnssecKeyModel {
+	var m []DnssecKeyModel
+	for _, k := range keys {
+		m = append(m, DnssecKeyModel{
+			Algorithm: tftypes.Int64Value(int64(aws.ToInt32(k.Algorithm))),
+			Flags:     tftypes.Int64Value(int64(aws.ToInt32(k.Flags))),
+			PublicKey: tftypes.StringValue(aws.ToString(k.PublicKey)),
+		})
+	}
+	return m
+}
+
+// registrationTimeout returns how long to wait for the registration and the
+// operations that follow it: timeouts.create when set, otherwise the
+// deprecated registration_timeout.
+func registrationTimeout(data DomainRegistrationResourceModel) time.Duration {
+	if data.Timeouts != nil && !data.Timeouts.Create.IsNull() && !data.Timeouts.Create.IsUnknown() {
+		if d, err := time.ParseDuration(data.Timeouts.Create.ValueString()); err == nil {
+			return d
+		}
+	}
+	return time.Duration(data.RegistrationTimeout.ValueInt64()) * time.Second
+}
+
+// manageHostedZone reports whether the provider should touch the
+// registrar-created hosted zone. A null value (state written before
+// manage_hosted_zone existed, or an import) keeps the default of true.
+func manageHostedZone(data DomainRegistrationResourceModel) bool {
+	return data.ManageHostedZone.IsNull() || data.ManageHostedZone.ValueBool()
+}
+
+// findHostedZone returns the first hosted zone named exactly domainName.
+// ListHostedZonesByName returns zones starting at the name, not only exact
+// matches, so pages are followed until the zone is found or none are left.
+func (r *DomainRegistrationResource) findHostedZone(ctx context.Context, domainName string) (*route53types.HostedZone, error) {
+	input := &route53.ListHostedZonesByNameInput{
+		DNSName: aws.String(domainName),
+	}
+
+	for {
+		output, err := r.route53Client.ListHostedZonesByName(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list hosted zones: %w", err)
+		}
+
+		for i := range output.HostedZones {
+			// Zone names have trailing dot, domain names don't
+			if strings.TrimSuffix(aws.ToString(output.HostedZones[i].Name), ".") == domainName {
+				return &output.HostedZones[i], nil
+			}
+		}
+
+		if !output.IsTruncated {
+			return nil, fmt.Errorf("%w for domain %s", errHostedZoneNotFound, domainName)
+		}
+		input.DNSName = output.NextDNSName
+		input.HostedZoneId = output.NextHostedZoneId
+	}
+}
+
+// findHostedZoneID looks up the Route53 hosted zone ID for a domain
+func (r *DomainRegistrationResource) findHostedZoneID(ctx context.Context, domainName string) (string, error) {
+	zone, err := r.findHostedZone(ctx, domainName)
+	if err != nil {
+		return "", err
+	}
+
+	// Zone ID format is "/hostedzone/Z1234567890ABC" - extract just the ID
+	return strings.TrimPrefix(aws.ToString(zone.Id), "/hostedzone/"), nil
+}
+
+// registrarNameservers returns the nameservers in the apex NS record of the
+// hosted zone, without trailing dots, or null when zoneID is null or the
+// record cannot be read.
+func (r *DomainRegistrationResource) registrarNameservers(ctx context.Context, domainName string, zoneID tftypes.String) tftypes.List {
+	if zoneID.IsNull() || zoneID.IsUnknown() {
+		return tftypes.ListNull(tftypes.StringType)
+	}
+
+	output, err := r.route53Client.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID.ValueString()),
+		StartRecordName: aws.String(domainName),
+		StartRecordType: route53types.RRTypeNs,
+		MaxItems:        aws.Int32(1),
+	})
+	if err != nil {
+		tflog.Warn(ctx, "Could not read hosted zone nameservers", map[string]interface{}{
+			"domain":  domainName,
+			"zone_id": zoneID.ValueString(),
+			"error":   err.Error(),
+		})
+		return tftypes.ListNull(tftypes.StringType)
+	}
+
+	for _, record := range output.ResourceRecordSets {
+		if record.Type != route53types.RRTypeNs || strings.TrimSuffix(aws.ToString(record.Name), ".") != domainName {
+			continue
+		}
+		nameservers := make([]attr.Value, 0, len(record.ResourceRecords))
+		for _, rr := range record.ResourceRecords {
+			nameservers = append(nameservers, tftypes.StringValue(strings.TrimSuffix(aws.ToString(rr.Value), ".")))
+		}
+		return tftypes.ListValueMust(tftypes.StringType, nameservers)
+	}
+	return tftypes.ListNull(tftypes.StringType)
+}
+
+// maxTagChanges is the most tags ChangeTagsForResource adds, or removes, in
+// one call.
+const maxTagChanges = 10
+
+// tagHostedZone sets tags on the hosted zone and removes the keys of prior
+// that are no longer in tags, leaving any other tags on the zone alone.
+func (r *DomainRegistrationResource) tagHostedZone(ctx context.Context, zoneID string, tags, prior map[string]tftypes.String) error {
+	var add []route53types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		add = append(add, route53types.Tag{Key: aws.String(key), Value: aws.String(tags[key].ValueString())})
+	}
+	var remove []string
+	for _, key := range slices.Sorted(maps.Keys(prior)) {
+		if _, ok := tags[key]; !ok {
+			remove = append(remove, key)
 		}
+	}
 
-		zoneID := aws.ToString(zone.Id)
+	for len(add) > 0 || len(remove) > 0 {
+		input := &route53.ChangeTagsForResourceInput{
+			ResourceType: route53types.TagResourceTypeHostedzone,
+			ResourceId:   aws.String(zoneID),
+		}
+		n := min(len(add), maxTagChanges)
+		input.AddTags, add = add[:n], add[n:]
+		n = min(len(remove), maxTagChanges)
+		input.RemoveTagKeys, remove = remove[:n], remove[n:]
 
-		// Safety check 1: must be public zone
-		if zone.Config != nil && zone.Config.PrivateZone {
-			tflog.Warn(ctx, "Hosted zone is private, skipping deletion", map[string]interface{}{
-				"domain":  domainName,
-				"zone_id": zoneID,
-			})
-			return fmt.Errorf("hosted zone is private, not deleting")
+		if _, err := r.route53Client.ChangeTagsForResource(ctx, input); err != nil {
+			return fmt.Errorf("failed to tag hosted zone %s: %w", zoneID, err)
+		}
+	}
+	return nil
+}
+
+// tagsIn returns the tags whose keys are in keys.
+func tagsIn(tags, keys map[string]tftypes.String) map[string]tftypes.String {
+	out := make(map[string]tftypes.String, len(keys))
+	for key := range keys {
+		if value, ok := tags[key]; ok {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+// readHostedZoneTags returns the zone's current values of the configured tag
+// keys, dropping keys the zone no longer has so the next plan restores them.
+func (r *DomainRegistrationResource) readHostedZoneTags(ctx context.Context, zoneID string, configured map[string]tftypes.String) (map[string]tftypes.String, error) {
+	output, err := r.route53Client.ListTagsForResource(ctx, &route53.ListTagsForResourceInput{
+		ResourceType: route53types.TagResourceTypeHostedzone,
+		ResourceId:   aws.String(zoneID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list hosted zone tags: %w", err)
+	}
+
+	current := map[string]string{}
+	if output.ResourceTagSet != nil {
+		for _, tag := range output.ResourceTagSet.Tags {
+			current[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+	tags := make(map[string]tftypes.String, len(configured))
+	for key := range configured {
+		if value, ok := current[key]; ok {
+			tags[key] = tftypes.StringValue(value)
+		}
+	}
+	return tags, nil
+}
+
+// waitForHostedZone polls for the registrar-created hosted zone, which Route53
+// creates asynchronously after registration, until it appears,
+// hostedZoneWaitTimeout expires, or ctx is cancelled.
+func (r *DomainRegistrationResource) waitForHostedZone(ctx context.Context, domainName string) (string, error) {
+	zoneID, err := Waiter[string]{
+		InitialDelay: hostedZonePollInterval,
+		Timeout:      hostedZoneWaitTimeout,
+		Poll: func(ctx context.Context) (string, error) {
+			zoneID, err := r.findHostedZoneID(ctx, domainName)
+			if errors.Is(err, errHostedZoneNotFound) {
+				tflog.Debug(ctx, "Waiting for registrar hosted zone", map[string]interface{}{
+					"domain": domainName,
+				})
+				return "", nil
+			}
+			return zoneID, err
+		},
+		Terminal: func(zoneID string) bool { return zoneID != "" },
+	}.Wait(ctx)
+	if errors.Is(err, errWaitTimeout) {
+		return "", errHostedZoneNotFound
+	}
+	return zoneID, err
+}
+
+// waitForContactUpdate waits for an UpdateDomainContact operation. Some
+// changes, such as a registrant change needing the owner's confirmation, are
+// only rejected once the operation runs, so a failure is reported here.
+func (r *DomainRegistrationResource) waitForContactUpdate(ctx context.Context, data DomainRegistrationResourceModel, operationID string, diags *diag.Diagnostics) {
+	if operationID == "" {
+		return
+	}
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+
+	// The registration timeout also bounds this wait
+	timeout := registrationTimeout(data)
+	opDetail, err := waitForOperation(ctx, r.client, operationID, timeout)
+	switch {
+	case errors.Is(err, errWaitTimeout):
+		diags.AddWarning(
+			"Contact update still in progress",
+			fmt.Sprintf("The contact update for %s (operation %s) did not complete within %s. It may be waiting for the registrant to confirm the change by email; the new contacts will be picked up on the next refresh once it completes.", domainName, operationID, waitLimit(err, timeout)),
+		)
+	case err != nil:
+		diags.AddError(
+			"Error checking contact update status",
+			fmt.Sprintf("Could not check contact update status for %s: %s", domainName, err.Error()),
+		)
+	case opDetail.Status != types.OperationStatusSuccessful:
+		diags.AddError(
+			"Contact update failed",
+			fmt.Sprintf("The contact update for %s (operation %s) finished with status %s: %s", domainName, operationID, opDetail.Status, aws.ToString(opDetail.Message)),
+		)
+	}
+}
+
+// renewDomain renews the domain for the years added to duration_years and
+// waits for the renewal operation to finish.
+func (r *DomainRegistrationResource) renewDomain(ctx context.Context, data, state DomainRegistrationResourceModel, resp *resource.UpdateResponse) {
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+	years := data.DurationYears.ValueInt64() - state.DurationYears.ValueInt64()
+
+	// RenewDomain requires the current expiry year to guard against double renewals
+	expiration, err := time.Parse(time.RFC3339, state.ExpirationDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error renewing domain",
+			fmt.Sprintf("Could not determine the current expiration year of %s from %q: %s", domainName, state.ExpirationDate.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Renewing domain", map[string]interface{}{
+		"domain": domainName,
+		"years":  years,
+	})
+
+	output, err := r.client.RenewDomain(ctx, &route53domains.RenewDomainInput{
+		DomainName:        aws.String(domainName),
+		DurationInYears:   aws.Int32(int32(years)),
+		CurrentExpiryYear: int32(expiration.Year()),
+	})
+	if err != nil {
+		addAPIError(&resp.Diagnostics, data,
+			"Error renewing domain",
+			fmt.Sprintf("Could not renew %s for %d year(s): %s", domainName, years, err.Error()),
+			err,
+		)
+		return
+	}
+
+	timeout := registrationTimeout(data)
+	opDetail, err := waitForOperation(ctx, r.client, aws.ToString(output.OperationId), timeout)
+	switch {
+	case errors.Is(err, errWaitTimeout):
+		resp.Diagnostics.AddWarning(
+			"Domain renewal still in progress",
+			fmt.Sprintf("Renewal of %s (operation %s) did not complete within %s. The new expiration date will be picked up on the next refresh.", domainName, aws.ToString(output.OperationId), waitLimit(err, timeout)),
+		)
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error checking renewal status",
+			fmt.Sprintf("Could not check renewal status for %s: %s", domainName, err.Error()),
+		)
+	case opDetail.Status != types.OperationStatusSuccessful:
+		resp.Diagnostics.AddError(
+			"Domain renewal failed",
+			fmt.Sprintf("Renewal of %s finished with status %s: %s", domainName, opDetail.Status, aws.ToString(opDetail.Message)),
+		)
+	}
+}
+
+// updateNameservers sets the configured nameservers and waits for the update
+// operation so a follow-up refresh sees the new values.
+func (r *DomainRegistrationResource) updateNameservers(ctx context.Context, data DomainRegistrationResourceModel, diags *diag.Diagnostics) {
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+
+	output, err := r.client.UpdateDomainNameservers(ctx, &route53domains.UpdateDomainNameserversInput{
+		DomainName:  aws.String(domainName),
+		Nameservers: nameserversToAWS(normalizeNameservers(data.Nameservers)),
+	})
+	if err != nil {
+		addAPIError(diags, data,
+			"Error updating nameservers",
+			fmt.Sprintf("Could not update nameservers for %s: %s", domainName, err.Error()),
+			err,
+		)
+		return
+	}
+	if output.OperationId == nil {
+		return
+	}
+
+	// The registration timeout also bounds this wait
+	timeout := registrationTimeout(data)
+	opDetail, err := waitForOperation(ctx, r.client, aws.ToString(output.OperationId), timeout)
+	switch {
+	case errors.Is(err, errWaitTimeout):
+		diags.AddWarning(
+			"Nameserver update still in progress",
+			fmt.Sprintf("The nameserver update for %s (operation %s) did not complete within %s. The new nameservers will be picked up on the next refresh.", domainName, aws.ToString(output.OperationId), waitLimit(err, timeout)),
+		)
+	case err != nil:
+		diags.AddError(
+			"Error checking nameserver update status",
+			fmt.Sprintf("Could not check nameserver update status for %s: %s", domainName, err.Error()),
+		)
+	case opDetail.Status != types.OperationStatusSuccessful:
+		diags.AddError(
+			"Nameserver update failed",
+			fmt.Sprintf("The nameserver update for %s finished with status %s: %s", domainName, opDetail.Status, aws.ToString(opDetail.Message)),
+		)
+	}
+}
+
+// resetNameservers points the domain back at the nameservers of its registrar
+// hosted zone, the defaults Route53 assigned at registration. Without a
+// managed zone to revert to, the current nameservers are left in place with a
+// warning.
+func (r *DomainRegistrationResource) resetNameservers(ctx context.Context, data DomainRegistrationResourceModel, diags *diag.Diagnostics) {
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+
+	reason := "manage_hosted_zone is false"
+	if manageHostedZone(data) {
+		zoneID := data.HostedZoneID
+		if zoneID.IsNull() || zoneID.IsUnknown() {
+			zoneID = tftypes.StringNull()
+			if hostedZoneID, err := r.findHostedZoneID(ctx, domainName); err == nil {
+				zoneID = tftypes.StringValue(hostedZoneID)
+			}
 		}
 
-		// Safety check 2: must have registrar comment
-		comment := ""
-		if zone.Config != nil && zone.Config.Comment != nil {
-			comment = *zone.Config.Comment
+		var defaults []NameserverModel
+		var names []string
+		for _, ns := range r.registrarNameservers(ctx, domainName, zoneID).Elements() {
+			defaults = append(defaults, NameserverModel{Name: ns.(tftypes.String)})
+			names = append(names, ns.(tftypes.String).ValueString())
 		}
-		if comment != "HostedZone created by Route53 Registrar" {
-			tflog.Warn(ctx, "Hosted zone not created by Route53 Registrar, skipping deletion", map[string]interface{}{
-				"domain":  domainName,
-				"zone_id": zoneID,
-				"comment": comment,
+		if len(defaults) > 0 {
+			tflog.Info(ctx, "Reverting to the registrar hosted zone's nameservers", map[string]interface{}{
+				"domain":      domainName,
+				"nameservers": strings.Join(names, ","),
 			})
-			return fmt.Errorf("hosted zone comment %q does not match expected registrar comment", comment)
+			data.Nameservers = defaults
+			r.updateNameservers(ctx, data, diags)
+			return
 		}
+		reason = "no nameservers were found for its registrar hosted zone"
+	}
 
-		// Safety check 3: must only have NS and SOA records
-		recordsOutput, err := r.route53Client.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
-			HostedZoneId: aws.String(zoneID),
+	diags.AddAttributeWarning(
+		path.Root("nameservers"),
+		"Nameservers left unchanged",
+		fmt.Sprintf("nameservers was removed from the configuration of %s, but %s, so the domain keeps its current nameservers. Set nameservers to change them.", domainName, reason),
+	)
+}
+
+// updateDnssecKeys moves the domain from the prior DNSSEC keys to the
+// configured ones. Every new key is associated, and its operation waited for,
+// before any old key is removed: a key rotation in one apply then never leaves
+// the parent zone without a DS record for a key that signs the zone. If adding
+// a key fails, the old keys are kept.
+func (r *DomainRegistrationResource) updateDnssecKeys(ctx context.Context, data DomainRegistrationResourceModel, prior []DnssecKeyModel, diags *diag.Diagnostics) {
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+	timeout := registrationTimeout(data)
+
+	for _, key := range dnssecKeysMissing(data.DnssecKeys, prior) {
+		tflog.Info(ctx, "Associating DNSSEC key", map[string]interface{}{
+			"domain":    domainName,
+			"algorithm": key.Algorithm.ValueInt64(),
+			"flags":     key.Flags.ValueInt64(),
+		})
+		output, err := r.client.AssociateDelegationSignerToDomain(ctx, &route53domains.AssociateDelegationSignerToDomainInput{
+			DomainName: aws.String(domainName),
+			SigningAttributes: &types.DnssecSigningAttributes{
+				Algorithm: aws.Int32(int32(key.Algorithm.ValueInt64())),
+				Flags:     aws.Int32(int32(key.Flags.ValueInt64())),
+				PublicKey: aws.String(key.PublicKey.ValueString()),
+			},
+		})
+		if err == nil {
+			err = r.waitForDnssecOperation(ctx, aws.ToString(output.OperationId), timeout)
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("dnssec_keys"),
+				"Error associating DNSSEC key",
+				fmt.Sprintf("Could not associate the DNSSEC key (algorithm %d, flags %d) with %s: %s. No keys were removed.", key.Algorithm.ValueInt64(), key.Flags.ValueInt64(), domainName, err.Error()),
+			)
+			return
+		}
+	}
+
+	removed := dnssecKeysMissing(prior, data.DnssecKeys)
+	if len(removed) == 0 {
+		return
+	}
+
+	// Disassociating takes the ID AWS assigned, which is not kept in state
+	detail, err := r.client.GetDomainDetail(ctx, &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		diags.AddError(
+			"Error reading DNSSEC keys",
+			fmt.Sprintf("Could not read the DNSSEC keys of %s to remove the old ones: %s", domainName, err.Error()),
+		)
+		return
+	}
+	ids := make(map[string]string, len(detail.DnssecKeys))
+	for _, k := range detail.DnssecKeys {
+		ids[dnssecKeyID(int64(aws.ToInt32(k.Algorithm)), int64(aws.ToInt32(k.Flags)), aws.ToString(k.PublicKey))] = aws.ToString(k.Id)
+	}
+
+	for _, key := range removed {
+		id, ok := ids[dnssecKeyModelID(key)]
+		if !ok {
+			// Already removed outside Terraform
+			continue
+		}
+		tflog.Info(ctx, "Disassociating DNSSEC key", map[string]interface{}{
+			"domain": domainName,
+			"id":     id,
+		})
+		output, err := r.client.DisassociateDelegationSignerFromDomain(ctx, &route53domains.DisassociateDelegationSignerFromDomainInput{
+			DomainName: aws.String(domainName),
+			Id:         aws.String(id),
+		})
+		if err == nil {
+			err = r.waitForDnssecOperation(ctx, aws.ToString(output.OperationId), timeout)
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("dnssec_keys"),
+				"Error disassociating DNSSEC key",
+				fmt.Sprintf("Could not disassociate DNSSEC key %s from %s: %s", id, domainName, err.Error()),
+			)
+			return
+		}
+	}
+}
+
+// waitForDnssecOperation waits for a DNSSEC key operation to succeed. Unlike
+// a nameserver update, one still running at the timeout is an error, since
+// the next step of a rotation depends on it.
+func (r *DomainRegistrationResource) waitForDnssecOperation(ctx context.Context, operationID string, timeout time.Duration) error {
+	if operationID == "" {
+		return nil
+	}
+	opDetail, err := waitForOperation(ctx, r.client, operationID, timeout)
+	switch {
+	case errors.Is(err, errWaitTimeout):
+		return fmt.Errorf("operation %s did not complete within %s", operationID, waitLimit(err, timeout))
+	case err != nil:
+		return err
+	case opDetail.Status != types.OperationStatusSuccessful:
+		return fmt.Errorf("operation %s finished with status %s: %s", operationID, opDetail.Status, aws.ToString(opDetail.Message))
+	}
+	return nil
+}
+
+// setAutoRenew enables or disables auto-renew for the domain.
+func (r *DomainRegistrationResource) setAutoRenew(ctx context.Context, domainName string, enable bool) error {
+	if enable {
+		_, err := r.client.EnableDomainAutoRenew(ctx, &route53domains.EnableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		})
+		return err
+	}
+	_, err := r.client.DisableDomainAutoRenew(ctx, &route53domains.DisableDomainAutoRenewInput{
+		DomainName: aws.String(domainName),
+	})
+	return err
+}
+
+// disableTransferLock removes the domain's transfer lock and waits for the
+// operation to succeed, since DeleteDomain is rejected while it is set.
+func (r *DomainRegistrationResource) disableTransferLock(ctx context.Context, domainName string, timeout time.Duration) error {
+	tflog.Info(ctx, "Disabling domain transfer lock", map[string]interface{}{
+		"domain": domainName,
+	})
+
+	output, err := r.client.DisableDomainTransferLock(ctx, &route53domains.DisableDomainTransferLockInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return err
+	}
+
+	opDetail, err := waitForOperation(ctx, r.client, aws.ToString(output.OperationId), timeout)
+	if err != nil {
+		return err
+	}
+	if opDetail.Status != types.OperationStatusSuccessful {
+		return fmt.Errorf("operation %s finished with status %s: %s", aws.ToString(output.OperationId), opDetail.Status, aws.ToString(opDetail.Message))
+	}
+	return nil
+}
+
+// readReachabilityStatus returns the registrant contact's email verification
+// status, or null if it cannot be determined (e.g. unsupported by the TLD).
+func (r *DomainRegistrationResource) readReachabilityStatus(ctx context.Context, domainName string) tftypes.String {
+	output, err := r.client.GetContactReachabilityStatus(ctx, &route53domains.GetContactReachabilityStatusInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		tflog.Warn(ctx, "Could not read contact reachability status", map[string]interface{}{
+			"domain": domainName,
+			"error":  err.Error(),
+		})
+		return tftypes.StringNull()
+	}
+	if output.Status == "" {
+		return tftypes.StringNull()
+	}
+	return tftypes.StringValue(string(output.Status))
+}
+
+// resendReachabilityEmail asks AWS to resend the registrant verification email.
+func (r *DomainRegistrationResource) resendReachabilityEmail(ctx context.Context, domainName string) error {
+	output, err := r.client.ResendContactReachabilityEmail(ctx, &route53domains.ResendContactReachabilityEmailInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Resent contact reachability email", map[string]interface{}{
+		"domain":              domainName,
+		"email":               aws.ToString(output.EmailAddress),
+		"is_already_verified": aws.ToBool(output.IsAlreadyVerified),
+	})
+	return nil
+}
+
+// registrarZoneComments returns the accepted registrar zone comments, falling
+// back to registrarZoneComment for state written before they were configurable.
+func registrarZoneComments(data DomainRegistrationResourceModel) []string {
+	if len(data.RegistrarZoneComments) == 0 {
+		return []string{registrarZoneComment}
+	}
+	comments := make([]string, 0, len(data.RegistrarZoneComments))
+	for _, c := range data.RegistrarZoneComments {
+		comments = append(comments, c.ValueString())
+	}
+	return comments
+}
+
+// recordDeletable reports whether a record matches one of deletable, by type
+// and, when set, by name. Names are compared case-insensitively and without
+// the trailing dot.
+func recordDeletable(record route53types.ResourceRecordSet, deletable []ZoneRecordModel) bool {
+	name := strings.TrimSuffix(strings.ToLower(aws.ToString(record.Name)), ".")
+	for _, d := range deletable {
+		if !strings.EqualFold(d.Type.ValueString(), string(record.Type)) {
+			continue
+		}
+		if d.Name.IsNull() || strings.TrimSuffix(canonicalDomainName(d.Name.ValueString()), ".") == name {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteRegistrarHostedZone safely deletes the hosted zone only if ALL conditions are met:
+// 1. Zone name matches the domain exactly
+// 2. Zone is public (not private)
+// 3. Zone comment is one of comments (registrar_zone_comments)
+// 4. Zone contains only NS and SOA records, and records matching deletable
+// (deletable_zone_records), which are deleted first
+func (r *DomainRegistrationResource) deleteRegistrarHostedZone(ctx context.Context, domainName string, comments []string, deletable []ZoneRecordModel) error {
+	zone, err := r.findHostedZone(ctx, domainName)
+	if err != nil {
+		return err
+	}
+
+	zoneID := aws.ToString(zone.Id)
+
+	// Safety check 1: must be public zone
+	if zone.Config != nil && zone.Config.PrivateZone {
+		tflog.Warn(ctx, "Hosted zone is private, skipping deletion", map[string]interface{}{
+			"domain":  domainName,
+			"zone_id": zoneID,
+		})
+		return &zoneSafetyError{ZoneID: strings.TrimPrefix(zoneID, "/hostedzone/"), Check: zoneCheckPrivate, Detail: "it is a private zone"}
+	}
+
+	// Safety check 2: must have registrar comment
+	comment := ""
+	if zone.Config != nil && zone.Config.Comment != nil {
+		comment = *zone.Config.Comment
+	}
+	if !slices.Contains(comments, comment) {
+		tflog.Warn(ctx, "Hosted zone not created by Route53 Registrar, skipping deletion", map[string]interface{}{
+			"domain":  domainName,
+			"zone_id": zoneID,
+			"comment": comment,
 		})
+		return &zoneSafetyError{
+			ZoneID: strings.TrimPrefix(zoneID, "/hostedzone/"),
+			Check:  zoneCheckComment,
+			Detail: fmt.Sprintf("its comment %q does not match any accepted registrar comment %q", comment, comments),
+		}
+	}
+
+	// Safety check 3: must only have NS and SOA records, besides the
+	// deletable records. Every page is read, as a custom record on a later
+	// page must keep the zone too.
+	var changes []route53types.Change
+	paginator := route53.NewListResourceRecordSetsPaginator(r.route53Client, &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	})
+	for paginator.HasMorePages() {
+		recordsOutput, err := paginator.NextPage(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to list records in hosted zone: %w", err)
 		}
 
 		for _, record := range recordsOutput.ResourceRecordSets {
 			recordType := string(record.Type)
-			if recordType != "NS" && recordType != "SOA" {
-				tflog.Warn(ctx, "Hosted zone has custom records, skipping deletion", map[string]interface{}{
-					"domain":      domainName,
-					"zone_id":     zoneID,
-					"record_name": aws.ToString(record.Name),
-					"record_type": recordType,
+			if recordType == "NS" || recordType == "SOA" {
+				continue
+			}
+			if recordDeletable(record, deletable) {
+				changes = append(changes, route53types.Change{
+					Action:            route53types.ChangeActionDelete,
+					ResourceRecordSet: &record,
 				})
-				return fmt.Errorf("hosted zone has custom record %s %s, not deleting", aws.ToString(record.Name), recordType)
+				continue
+			}
+			tflog.Warn(ctx, "Hosted zone has custom records, skipping deletion", map[string]interface{}{
+				"domain":      domainName,
+				"zone_id":     zoneID,
+				"record_name": aws.ToString(record.Name),
+				"record_type": recordType,
+			})
+			return &zoneSafetyError{
+				ZoneID: strings.TrimPrefix(zoneID, "/hostedzone/"),
+				Check:  zoneCheckRecords,
+				Detail: fmt.Sprintf("it has the custom record %s %s", aws.ToString(record.Name), recordType),
 			}
 		}
+	}
 
-		// All checks passed - safe to delete
-		tflog.Info(ctx, "Deleting Route53 Registrar hosted zone", map[string]interface{}{
+	// Route53 only deletes a zone holding nothing but its NS and SOA records
+	if len(changes) > 0 {
+		tflog.Info(ctx, "Deleting deletable records from registrar hosted zone", map[string]interface{}{
 			"domain":  domainName,
 			"zone_id": zoneID,
+			"records": len(changes),
 		})
-
-		_, err = r.route53Client.DeleteHostedZone(ctx, &route53.DeleteHostedZoneInput{
-			Id: aws.String(zoneID),
+		_, err = r.route53Client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zoneID),
+			ChangeBatch:  &route53types.ChangeBatch{Changes: changes},
 		})
 		if err != nil {
-			return fmt.Errorf("failed to delete hosted zone: %w", err)
+			return fmt.Errorf("failed to delete records in hosted zone: %w", err)
 		}
+	}
 
-		return nil
+	// All checks passed - safe to delete
+	tflog.Info(ctx, "Deleting Route53 Registrar hosted zone", map[string]interface{}{
+		"domain":  domainName,
+		"zone_id": zoneID,
+	})
+
+	_, err = r.route53Client.DeleteHostedZone(ctx, &route53.DeleteHostedZoneInput{
+		Id: aws.String(zoneID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete hosted zone: %w", err)
 	}
 
-	return fmt.Errorf("hosted zone not found for domain %s", domainName)
+	return nil
 }
 
 func (r *DomainRegistrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -392,103 +2262,175 @@ func (r *DomainRegistrationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	domainName := data.DomainName.ValueString()
+	data.TagsAll = tagsAllValue(mergeTags(r.defaultTags, data.Tags))
+	data.ContactTemplateHashes = r.contactTemplateHashes(&data)
+
+	domainName := canonicalDomainName(data.DomainName.ValueString())
 	tflog.Info(ctx, "Registering domain", map[string]interface{}{
 		"domain": domainName,
 	})
 
-	// Build registration request
-	registerInput := &route53domains.RegisterDomainInput{
-		DomainName:                      aws.String(domainName),
-		DurationInYears:                 aws.Int32(int32(data.DurationYears.ValueInt64())),
-		AutoRenew:                       aws.Bool(data.AutoRenew.ValueBool()),
-		AdminContact:                    contactModelToAWS(data.AdminContact),
-		RegistrantContact:               contactModelToAWS(data.RegistrantContact),
-		TechContact:                     contactModelToAWS(data.TechContact),
-		PrivacyProtectAdminContact:      aws.Bool(data.AdminPrivacy.ValueBool()),
-		PrivacyProtectRegistrantContact: aws.Bool(data.RegistrantPrivacy.ValueBool()),
-		PrivacyProtectTechContact:       aws.Bool(data.TechPrivacy.ValueBool()),
-	}
-
-	// Register the domain
-	registerOutput, err := r.client.RegisterDomain(ctx, registerInput)
+	// A registration submitted by an apply that was interrupted before saving
+	// state is still running, and registering again would fail, so it is
+	// waited on instead
+	operationID, err := r.pendingRegistration(ctx, domainName)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error registering domain",
-			fmt.Sprintf("Could not register domain %s: %s", domainName, err.Error()),
-		)
-		return
+		tflog.Warn(ctx, "Could not check for a pending registration", map[string]interface{}{
+			"domain": domainName,
+			"error":  err.Error(),
+		})
 	}
-
-	tflog.Info(ctx, "Domain registration initiated", map[string]interface{}{
-		"domain":       domainName,
-		"operation_id": *registerOutput.OperationId,
-	})
-
-	// Wait for registration to complete
-	timeout := time.Duration(data.RegistrationTimeout.ValueInt64()) * time.Second
-	deadline := time.Now().Add(timeout)
-
-	for time.Now().Before(deadline) {
-		opDetail, err := r.client.GetOperationDetail(ctx, &route53domains.GetOperationDetailInput{
-			OperationId: registerOutput.OperationId,
+	if operationID != "" {
+		tflog.Info(ctx, "Resuming pending domain registration", map[string]interface{}{
+			"domain":       domainName,
+			"operation_id": operationID,
 		})
+		resp.Diagnostics.AddWarning(
+			"Resuming domain registration",
+			fmt.Sprintf("A registration of %s is already in progress (operation %s), probably submitted by an earlier apply that stopped before saving state. Waiting for it instead of registering again; it uses the contacts and duration it was submitted with.", domainName, operationID),
+		)
+	} else {
+		// Build registration request
+		admin, registrant, tech := r.roleContacts(&data)
+		registerInput := &route53domains.RegisterDomainInput{
+			DomainName:                      aws.String(domainName),
+			DurationInYears:                 aws.Int32(int32(data.DurationYears.ValueInt64())),
+			AutoRenew:                       aws.Bool(data.AutoRenew.ValueBool()),
+			AdminContact:                    contactModelToAWS(admin),
+			RegistrantContact:               contactModelToAWS(registrantWithBilling(domainName, registrant, data.BillingContact)),
+			TechContact:                     contactModelToAWS(tech),
+			BillingContact:                  contactModelToAWS(data.BillingContact),
+			PrivacyProtectAdminContact:      aws.Bool(data.AdminPrivacy.ValueBool()),
+			PrivacyProtectRegistrantContact: aws.Bool(data.RegistrantPrivacy.ValueBool()),
+			PrivacyProtectTechContact:       aws.Bool(data.TechPrivacy.ValueBool()),
+			PrivacyProtectBillingContact:    data.BillingPrivacy.ValueBoolPointer(),
+		}
+
+		// Register the domain
+		registerOutput, err := r.client.RegisterDomain(ctx, registerInput)
 		if err != nil {
+			detail := fmt.Sprintf("Could not register domain %s: %s", domainName, err.Error())
+			if r.ownsDomain(ctx, domainName) {
+				detail += ". The domain is already registered in this account, possibly by an earlier apply that stopped before saving state; import it instead of applying again."
+			}
+			addAPIError(&resp.Diagnostics, data, "Error registering domain", detail, err)
+			return
+		}
+
+		operationID = aws.ToString(registerOutput.OperationId)
+		if operationID == "" {
 			resp.Diagnostics.AddError(
-				"Error checking registration status",
-				fmt.Sprintf("Could not check registration status for %s: %s", domainName, err.Error()),
+				"Missing registration operation ID",
+				fmt.Sprintf("AWS accepted the registration of %s but returned no operation ID, so its progress cannot be tracked. "+
+					"Check the registration with the awsdomains_operations data source and, once it succeeds, import the domain instead of applying again.", domainName),
 			)
 			return
 		}
 
-		tflog.Debug(ctx, "Registration operation status", map[string]interface{}{
-			"domain": domainName,
-			"status": opDetail.Status,
+		tflog.Info(ctx, "Domain registration initiated", map[string]interface{}{
+			"domain":       domainName,
+			"operation_id": operationID,
 		})
+	}
+
+	data.ID = tftypes.StringValue(domainName)
+	data.OperationID = tftypes.StringNull()
 
-		if opDetail.Status == types.OperationStatusSuccessful {
-			break
+	// Wait for registration to complete
+	timeout := registrationTimeout(data)
+	opDetail, err := waitForOperation(ctx, r.client, operationID, timeout)
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errWaitTimeout):
+		// Record the pending operation so Read can reconcile it instead of
+		// the next apply registering the domain again
+		fields := map[string]interface{}{
+			"domain":       domainName,
+			"operation_id": operationID,
+			"error":        err.Error(),
 		}
-		if opDetail.Status == types.OperationStatusFailed {
-			resp.Diagnostics.AddError(
-				"Domain registration failed",
-				fmt.Sprintf("Domain registration for %s failed: %s", domainName, aws.ToString(opDetail.Message)),
-			)
-			return
+		if elapsed := operationElapsed(opDetail, time.Now()); elapsed > 0 {
+			fields["elapsed"] = elapsed.String()
+		}
+		tflog.Warn(ctx, "Stopped waiting for domain registration", fields)
+		data.OperationID = tftypes.StringValue(operationID)
+		data.Status = tftypes.StringValue(string(types.OperationStatusSubmitted))
+		if opDetail != nil {
+			data.Status = tftypes.StringValue(string(opDetail.Status))
 		}
-		if opDetail.Status == types.OperationStatusError {
+		data.ExpirationDate = tftypes.StringNull()
+		data.DaysUntilExpiry = tftypes.Int64Null()
+		data.CreationDate = tftypes.StringNull()
+		data.HostedZoneID = tftypes.StringNull()
+		data.RegistrarNameservers = tftypes.ListNull(tftypes.StringType)
+		data.ReachabilityStatus = tftypes.StringNull()
+		setRegistrarInfo(&data, nil)
+		setContactHashes(&data, nil)
+		r.setPrivacyEffective(&data, nil)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if !errors.Is(err, errWaitTimeout) {
 			resp.Diagnostics.AddError(
-				"Domain registration error",
-				fmt.Sprintf("Domain registration for %s encountered an error: %s", domainName, aws.ToString(opDetail.Message)),
+				"Domain registration interrupted",
+				fmt.Sprintf("Stopped waiting for registration of %s (operation %s): %s.%s The pending operation has been saved to state; the next plan will check its status instead of registering again.", domainName, operationID, err.Error(), operationAge(opDetail, time.Now())),
 			)
 			return
 		}
-
-		time.Sleep(10 * time.Second)
+		resp.Diagnostics.AddWarning(
+			"Domain registration still in progress",
+			fmt.Sprintf("Registration of %s did not complete within %s. Operation %s is still %s.%s The next plan will check the operation status instead of registering again.", domainName, waitLimit(err, timeout), operationID, data.Status.ValueString(), operationAge(opDetail, time.Now())),
+		)
+		return
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error checking registration status",
+			fmt.Sprintf("Could not check registration status for %s: %s", domainName, err.Error()),
+		)
+		return
+	case opDetail.Status == types.OperationStatusFailed:
+		resp.Diagnostics.AddError(
+			"Domain registration failed",
+			fmt.Sprintf("Domain registration for %s failed: %s", domainName, aws.ToString(opDetail.Message)),
+		)
+		return
+	case opDetail.Status == types.OperationStatusError:
+		resp.Diagnostics.AddError(
+			"Domain registration error",
+			fmt.Sprintf("Domain registration for %s encountered an error: %s", domainName, aws.ToString(opDetail.Message)),
+		)
+		return
 	}
 
 	// Update nameservers if specified
 	if len(data.Nameservers) > 0 {
-		var nameservers []types.Nameserver
-		for _, ns := range data.Nameservers {
-			nameservers = append(nameservers, types.Nameserver{
-				Name: aws.String(ns.ValueString()),
-			})
+		r.updateNameservers(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+	}
 
-		_, err := r.client.UpdateDomainNameservers(ctx, &route53domains.UpdateDomainNameserversInput{
-			DomainName:  aws.String(domainName),
-			Nameservers: nameservers,
-		})
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating nameservers",
-				fmt.Sprintf("Could not update nameservers for %s: %s", domainName, err.Error()),
-			)
+	if len(data.DnssecKeys) > 0 {
+		r.updateDnssecKeys(ctx, data, nil, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
+	if data.SkipDetailRefresh.ValueBool() {
+		// State comes from the inputs and the operation; the next refresh
+		// fills in what only GetDomainDetail reports
+		data.Status = tftypes.StringValue(string(opDetail.Status))
+		data.ExpirationDate = tftypes.StringNull()
+		data.DaysUntilExpiry = tftypes.Int64Null()
+		data.CreationDate = tftypes.StringNull()
+		setRegistrarInfo(&data, nil)
+		setContactHashes(&data, nil)
+		r.setPrivacyEffective(&data, nil)
+
+		r.finishRegistration(ctx, &data, &resp.Diagnostics)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	// Get domain details
 	domainDetail, err := r.client.GetDomainDetail(ctx, &route53domains.GetDomainDetailInput{
 		DomainName: aws.String(domainName),
@@ -501,27 +2443,97 @@ func (r *DomainRegistrationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	// Update state
-	data.ID = tftypes.StringValue(domainName)
-	if domainDetail.ExpirationDate != nil {
-		data.ExpirationDate = tftypes.StringValue(domainDetail.ExpirationDate.Format(time.RFC3339))
-	}
-	if domainDetail.CreationDate != nil {
-		data.CreationDate = tftypes.StringValue(domainDetail.CreationDate.Format(time.RFC3339))
+	// Some registries ignore the requested auto-renew setting and apply their
+	// own default, so it is set explicitly when the domain disagrees. A failure
+	// only warns: the domain is registered, and the next refresh reports the
+	// actual setting as drift for the following apply to correct.
+	if domainDetail.AutoRenew != nil && *domainDetail.AutoRenew != data.AutoRenew.ValueBool() {
+		if err := r.setAutoRenew(ctx, domainName, data.AutoRenew.ValueBool()); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("auto_renew"),
+				"Could not apply auto-renew setting",
+				fmt.Sprintf("%s was registered with auto_renew = %t instead of the configured %t, and correcting it failed: %s. The next plan will show the difference.", domainName, *domainDetail.AutoRenew, data.AutoRenew.ValueBool(), err.Error()),
+			)
+		}
 	}
+
+	// Update state
+	setDomainDates(&data, domainDetail)
 	if len(domainDetail.StatusList) > 0 {
 		data.Status = tftypes.StringValue(string(domainDetail.StatusList[0]))
 	}
+	setRegistrarInfo(&data, domainDetail)
+	setContactHashes(&data, domainDetail)
+	r.setPrivacyEffective(&data, domainDetail)
+
+	r.finishRegistration(ctx, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// pendingRegistration returns the ID of a submitted or in-progress
+// REGISTER_DOMAIN operation for domainName, or "" when there is none.
+func (r *DomainRegistrationResource) pendingRegistration(ctx context.Context, domainName string) (string, error) {
+	paginator := route53domains.NewListOperationsPaginator(r.client, &route53domains.ListOperationsInput{
+		Status: []types.OperationStatus{types.OperationStatusSubmitted, types.OperationStatusInProgress},
+		Type:   []types.OperationType{types.OperationTypeRegisterDomain},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, op := range page.Operations {
+			if canonicalDomainName(aws.ToString(op.DomainName)) == domainName && aws.ToString(op.OperationId) != "" {
+				return aws.ToString(op.OperationId), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// ownsDomain reports whether domainName is registered in this account.
+func (r *DomainRegistrationResource) ownsDomain(ctx context.Context, domainName string) bool {
+	_, err := r.client.GetDomainDetail(ctx, &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domainName),
+	})
+	return err == nil
+}
+
+// finishRegistration runs the steps that follow a successful registration:
+// resending the reachability email and handling the registrar's hosted zone.
+// Create calls it directly, and Read calls it when it reconciles a
+// registration that completed after Create stopped waiting.
+func (r *DomainRegistrationResource) finishRegistration(ctx context.Context, data *DomainRegistrationResourceModel, diags *diag.Diagnostics) {
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+
+	// Resend the registrant verification email if requested
+	if data.ResendReachabilityEmail.ValueBool() {
+		if err := r.resendReachabilityEmail(ctx, domainName); err != nil {
+			diags.AddWarning(
+				"Could not resend contact verification email",
+				fmt.Sprintf("Could not resend the contact reachability email for %s: %s", domainName, err.Error()),
+			)
+		}
+	}
+	data.ReachabilityStatus = r.readReachabilityStatus(ctx, domainName)
 
 	// Handle the auto-created hosted zone
-	if data.DeleteHostedZone.ValueBool() {
-		// Delete the registrar-created hosted zone
-		err := r.deleteRegistrarHostedZone(ctx, domainName)
+	if !manageHostedZone(*data) {
+		data.HostedZoneID = tftypes.StringNull()
+	} else if data.DeleteHostedZone.ValueBool() {
+		// The registrar creates the zone asynchronously, so wait for it to
+		// appear before deleting it
+		_, err := r.waitForHostedZone(ctx, domainName)
+		if err == nil {
+			err = r.deleteRegistrarHostedZone(ctx, domainName, registrarZoneComments(*data), data.DeletableZoneRecords)
+		}
 		if err != nil {
 			tflog.Warn(ctx, "Could not delete hosted zone", map[string]interface{}{
 				"domain": domainName,
 				"error":  err.Error(),
 			})
+			addZoneDeletionWarning(diags, domainName, err)
 			// Still try to get the zone ID for state
 			if hostedZoneID, lookupErr := r.findHostedZoneID(ctx, domainName); lookupErr == nil {
 				data.HostedZoneID = tftypes.StringValue(hostedZoneID)
@@ -535,8 +2547,14 @@ func (r *DomainRegistrationResource) Create(ctx context.Context, req resource.Cr
 			data.HostedZoneID = tftypes.StringNull()
 		}
 	} else {
-		// Look up the auto-created hosted zone
-		hostedZoneID, err := r.findHostedZoneID(ctx, domainName)
+		// Look up the auto-created hosted zone, waiting for it when it is to
+		// be tagged
+		tags := tagsAllMap(data.TagsAll)
+		find := r.findHostedZoneID
+		if len(tags) > 0 {
+			find = r.waitForHostedZone
+		}
+		hostedZoneID, err := find(ctx, domainName)
 		if err != nil {
 			tflog.Warn(ctx, "Could not find hosted zone for domain", map[string]interface{}{
 				"domain": domainName,
@@ -546,9 +2564,19 @@ func (r *DomainRegistrationResource) Create(ctx context.Context, req resource.Cr
 		} else {
 			data.HostedZoneID = tftypes.StringValue(hostedZoneID)
 		}
+		if len(tags) > 0 {
+			if err == nil {
+				err = r.tagHostedZone(ctx, hostedZoneID, tags, nil)
+			}
+			if err != nil {
+				diags.AddWarning(
+					"Could not tag hosted zone",
+					fmt.Sprintf("The hosted zone of %s was not tagged: %s. The tags will be applied on a later apply.", domainName, err.Error()),
+				)
+			}
+		}
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	data.RegistrarNameservers = r.registrarNameservers(ctx, domainName, data.HostedZoneID)
 }
 
 func (r *DomainRegistrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -559,47 +2587,185 @@ func (r *DomainRegistrationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	domainName := data.DomainName.ValueString()
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+
+	// Reconcile a registration that was still pending when Create timed out
+	registrationCompleted := false
+	if data.OperationID.ValueString() != "" {
+		opDetail, err := r.client.GetOperationDetail(ctx, &route53domains.GetOperationDetailInput{
+			OperationId: aws.String(data.OperationID.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error checking registration status",
+				fmt.Sprintf("Could not check registration operation %s for %s: %s", data.OperationID.ValueString(), domainName, err.Error()),
+			)
+			return
+		}
+
+		switch opDetail.Status {
+		case types.OperationStatusSuccessful:
+			tflog.Info(ctx, "Pending domain registration completed", map[string]interface{}{
+				"domain":       domainName,
+				"operation_id": data.OperationID.ValueString(),
+			})
+			data.OperationID = tftypes.StringNull()
+			registrationCompleted = true
+		case types.OperationStatusFailed, types.OperationStatusError:
+			resp.Diagnostics.AddWarning(
+				"Domain registration failed",
+				fmt.Sprintf("Registration operation %s for %s finished with status %s: %s. The domain has been removed from state.", data.OperationID.ValueString(), domainName, opDetail.Status, aws.ToString(opDetail.Message)),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		default:
+			tflog.Info(ctx, "Domain registration still pending", map[string]interface{}{
+				"domain":       domainName,
+				"operation_id": data.OperationID.ValueString(),
+				"status":       opDetail.Status,
+			})
+			data.Status = tftypes.StringValue(string(opDetail.Status))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
 
 	domainDetail, err := r.client.GetDomainDetail(ctx, &route53domains.GetDomainDetailInput{
 		DomainName: aws.String(domainName),
 	})
 	if err != nil {
-		// If domain not found, remove from state
-		resp.State.RemoveResource(ctx)
+		if isDomainNotFound(err) {
+			tflog.Warn(ctx, "Domain not found, removing from state", map[string]interface{}{
+				"domain": domainName,
+				"error":  err.Error(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading domain details",
+			fmt.Sprintf("Could not read domain details for %s: %s", domainName, err.Error()),
+		)
 		return
 	}
 
-	// Update computed fields
+	// Update computed fields. auto_renew is taken from AWS, which may enable
+	// it on a transferred-in domain, so drift plans a change Update applies.
 	data.ID = tftypes.StringValue(domainName)
 	if domainDetail.AutoRenew != nil {
 		data.AutoRenew = tftypes.BoolValue(*domainDetail.AutoRenew)
 	}
-	if domainDetail.ExpirationDate != nil {
-		data.ExpirationDate = tftypes.StringValue(domainDetail.ExpirationDate.Format(time.RFC3339))
-	}
-	if domainDetail.CreationDate != nil {
-		data.CreationDate = tftypes.StringValue(domainDetail.CreationDate.Format(time.RFC3339))
-	}
+	// duration_years is kept from state. AWS does not report the
+	// registration period, and the years between creation and expiry also
+	// count auto-renewals and renewals made elsewhere, which would plan a
+	// decrease durationYearsModifier rejects. Those show in expiration_date.
+	setDomainDates(&data, domainDetail)
 	if len(domainDetail.StatusList) > 0 {
 		data.Status = tftypes.StringValue(string(domainDetail.StatusList[0]))
 	}
+	setRegistrarInfo(&data, domainDetail)
+	setContactHashes(&data, domainDetail)
+	r.setPrivacyEffective(&data, domainDetail)
+
+	// Update nameservers from AWS. When none are configured the registrar
+	// defaults are in use, so they are left unset to avoid a diff. AWS reports
+	// them as a set, so the configured order and spelling are kept unless the
+	// set itself changed.
+	if len(data.Nameservers) > 0 && len(domainDetail.Nameservers) > 0 {
+		if current := nameserversFromAWS(domainDetail.Nameservers); !nameserversEqual(current, data.Nameservers) {
+			data.Nameservers = current
+		}
+	}
+
+	// DNSSEC keys are likewise only tracked once configured
+	if len(data.DnssecKeys) > 0 {
+		if current := dnssecKeysFromAWS(domainDetail.DnssecKeys); !dnssecKeysEqual(current, data.DnssecKeys) {
+			data.DnssecKeys = current
+		}
+	}
+
+	// Update privacy settings from AWS
+	if domainDetail.AdminPrivacy != nil {
+		data.AdminPrivacy = tftypes.BoolValue(*domainDetail.AdminPrivacy)
+	}
+	if domainDetail.RegistrantPrivacy != nil {
+		data.RegistrantPrivacy = tftypes.BoolValue(*domainDetail.RegistrantPrivacy)
+	}
+	if domainDetail.TechPrivacy != nil {
+		data.TechPrivacy = tftypes.BoolValue(*domainDetail.TechPrivacy)
+	}
+	// billing_privacy, like billing_contact, is only refreshed once configured
+	if !data.BillingPrivacy.IsNull() && domainDetail.BillingPrivacy != nil {
+		data.BillingPrivacy = tftypes.BoolValue(*domainDetail.BillingPrivacy)
+	}
 
-	// Update nameservers from AWS
-	if len(domainDetail.Nameservers) > 0 {
-		var nameservers []tftypes.String
-		for _, ns := range domainDetail.Nameservers {
-			nameservers = append(nameservers, tftypes.StringValue(aws.ToString(ns.Name)))
+	// Update contacts from AWS, except where privacy protection redacts them.
+	// Roles that fall back to their template or the shared contact are left
+	// unset.
+	if data.AdminContact != nil || (data.Contact == nil && data.AdminContactTemplate.IsNull()) {
+		data.AdminContact = contactFromAWS(data.AdminContact, domainDetail.AdminContact, aws.ToBool(domainDetail.AdminPrivacy))
+	}
+	if data.RegistrantContact != nil || (data.Contact == nil && data.RegistrantContactTemplate.IsNull()) {
+		// Params carried for billing_contact belong to it, not the registrant
+		fallback := billingFallback(domainName, data.RegistrantContact, data.BillingContact)
+		data.RegistrantContact = contactFromAWS(data.RegistrantContact, domainDetail.RegistrantContact, aws.ToBool(domainDetail.RegistrantPrivacy))
+		if data.RegistrantContact != nil && data.RegistrantContact.ExtraParams != nil {
+			for name := range fallback {
+				delete(data.RegistrantContact.ExtraParams, name)
+			}
 		}
-		data.Nameservers = nameservers
 	}
+	if data.TechContact != nil || (data.Contact == nil && data.TechContactTemplate.IsNull()) {
+		data.TechContact = contactFromAWS(data.TechContact, domainDetail.TechContact, aws.ToBool(domainDetail.TechPrivacy))
+	}
+	// billing_contact is only refreshed once configured, as AWS may report
+	// one for a domain that never set it
+	if data.BillingContact != nil {
+		data.BillingContact = contactFromAWS(data.BillingContact, domainDetail.BillingContact, aws.ToBool(domainDetail.BillingPrivacy))
+	}
+
+	// A registration that completed since Create stopped waiting still needs
+	// the steps Create would have run after it
+	if registrationCompleted {
+		r.finishRegistration(ctx, &data, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data.ReachabilityStatus = r.readReachabilityStatus(ctx, domainName)
 
 	// Refresh hosted zone ID
-	hostedZoneID, err := r.findHostedZoneID(ctx, domainName)
-	if err != nil {
-		data.HostedZoneID = tftypes.StringNull()
-	} else {
-		data.HostedZoneID = tftypes.StringValue(hostedZoneID)
+	data.HostedZoneID = tftypes.StringNull()
+	if manageHostedZone(data) {
+		if hostedZoneID, err := r.findHostedZoneID(ctx, domainName); err == nil {
+			data.HostedZoneID = tftypes.StringValue(hostedZoneID)
+		}
+	}
+	data.RegistrarNameservers = r.registrarNameservers(ctx, domainName, data.HostedZoneID)
+
+	// Refresh the configured and applied zone tags so out-of-band changes
+	// show as drift
+	tagsAll := tagsAllMap(data.TagsAll)
+	if (data.Tags != nil || tagsAll != nil) && !data.HostedZoneID.IsNull() {
+		keys := maps.Clone(tagsAll)
+		if keys == nil {
+			keys = map[string]tftypes.String{}
+		}
+		maps.Copy(keys, data.Tags)
+		if current, err := r.readHostedZoneTags(ctx, data.HostedZoneID.ValueString(), keys); err == nil {
+			if data.Tags != nil {
+				data.Tags = tagsIn(current, data.Tags)
+			}
+			if tagsAll != nil {
+				data.TagsAll = tagsAllValue(tagsIn(current, tagsAll))
+			}
+		} else {
+			tflog.Warn(ctx, "Could not read hosted zone tags", map[string]interface{}{
+				"domain": domainName,
+				"error":  err.Error(),
+			})
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -615,85 +2781,141 @@ func (r *DomainRegistrationResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	domainName := data.DomainName.ValueString()
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+	data.ContactTemplateHashes = r.contactTemplateHashes(&data)
+
+	if state.OperationID.ValueString() != "" {
+		resp.Diagnostics.AddError(
+			"Domain registration still in progress",
+			fmt.Sprintf("Registration operation %s for %s has not completed yet. Wait for it to finish (status: %s) before changing the domain.", state.OperationID.ValueString(), domainName, state.Status.ValueString()),
+		)
+		return
+	}
+	data.OperationID = tftypes.StringNull()
 
 	// Update auto-renew if changed
 	if data.AutoRenew.ValueBool() != state.AutoRenew.ValueBool() {
-		if data.AutoRenew.ValueBool() {
-			_, err := r.client.EnableDomainAutoRenew(ctx, &route53domains.EnableDomainAutoRenewInput{
-				DomainName: aws.String(domainName),
-			})
-			if err != nil {
-				resp.Diagnostics.AddError(
+		if err := r.setAutoRenew(ctx, domainName, data.AutoRenew.ValueBool()); err != nil {
+			if data.AutoRenew.ValueBool() {
+				addAPIError(&resp.Diagnostics, data,
 					"Error enabling auto-renew",
 					fmt.Sprintf("Could not enable auto-renew for %s: %s", domainName, err.Error()),
+					err,
 				)
-				return
-			}
-		} else {
-			_, err := r.client.DisableDomainAutoRenew(ctx, &route53domains.DisableDomainAutoRenewInput{
-				DomainName: aws.String(domainName),
-			})
-			if err != nil {
-				resp.Diagnostics.AddError(
+			} else {
+				addAPIError(&resp.Diagnostics, data,
 					"Error disabling auto-renew",
 					fmt.Sprintf("Could not disable auto-renew for %s: %s", domainName, err.Error()),
+					err,
 				)
-				return
 			}
+			return
 		}
 	}
 
-	// Update nameservers if changed
-	if len(data.Nameservers) > 0 {
-		var nameservers []types.Nameserver
-		for _, ns := range data.Nameservers {
-			nameservers = append(nameservers, types.Nameserver{
-				Name: aws.String(ns.ValueString()),
-			})
+	// Renew for the added years if duration_years increased
+	if !state.DurationYears.IsNull() && data.DurationYears.ValueInt64() > state.DurationYears.ValueInt64() {
+		r.renewDomain(ctx, data, state, resp)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+	}
 
-		_, err := r.client.UpdateDomainNameservers(ctx, &route53domains.UpdateDomainNameserversInput{
-			DomainName:  aws.String(domainName),
-			Nameservers: nameservers,
-		})
+	// Update nameservers if changed, reverting to the registrar hosted zone's
+	// when they are removed from configuration
+	switch {
+	case len(data.Nameservers) > 0 && !nameserversEqual(data.Nameservers, state.Nameservers):
+		r.updateNameservers(ctx, data, &resp.Diagnostics)
+	case len(data.Nameservers) == 0 && len(state.Nameservers) > 0:
+		r.resetNameservers(ctx, data, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !dnssecKeysEqual(data.DnssecKeys, state.DnssecKeys) {
+		r.updateDnssecKeys(ctx, data, state.DnssecKeys, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Update contacts if changed, sending only the contacts that differ
+	contactInput := &route53domains.UpdateDomainContactInput{
+		DomainName: aws.String(domainName),
+	}
+	// A template changed in the provider configuration resolves to the same
+	// contact for the plan and the state, so its hash tells the change apart
+	admin, registrant, tech := r.roleContacts(&data)
+	priorAdmin, priorRegistrant, priorTech := r.roleContacts(&state)
+	if !contactsEqual(admin, priorAdmin) || templateHashChanged(data.ContactTemplateHashes, state.ContactTemplateHashes, "admin") {
+		contactInput.AdminContact = contactModelToAWS(admin)
+	}
+	registrant = registrantWithBilling(domainName, registrant, data.BillingContact)
+	if !contactsEqual(registrant, registrantWithBilling(domainName, priorRegistrant, state.BillingContact)) || templateHashChanged(data.ContactTemplateHashes, state.ContactTemplateHashes, "registrant") {
+		contactInput.RegistrantContact = contactModelToAWS(registrant)
+	}
+	if !contactsEqual(tech, priorTech) || templateHashChanged(data.ContactTemplateHashes, state.ContactTemplateHashes, "tech") {
+		contactInput.TechContact = contactModelToAWS(tech)
+	}
+	// Removing billing_contact leaves the one AWS holds in place
+	if data.BillingContact != nil && !contactsEqual(data.BillingContact, state.BillingContact) {
+		contactInput.BillingContact = contactModelToAWS(data.BillingContact)
+	}
+	if contactInput.AdminContact != nil || contactInput.RegistrantContact != nil || contactInput.TechContact != nil || contactInput.BillingContact != nil {
+		output, err := r.client.UpdateDomainContact(ctx, contactInput)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error updating nameservers",
-				fmt.Sprintf("Could not update nameservers for %s: %s", domainName, err.Error()),
+			addAPIError(&resp.Diagnostics, data,
+				"Error updating contacts",
+				fmt.Sprintf("Could not update contacts for %s: %s", domainName, err.Error()),
+				err,
 			)
 			return
 		}
+		r.waitForContactUpdate(ctx, data, aws.ToString(output.OperationId), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// Update contacts if changed
-	_, err := r.client.UpdateDomainContact(ctx, &route53domains.UpdateDomainContactInput{
-		DomainName:        aws.String(domainName),
-		AdminContact:      contactModelToAWS(data.AdminContact),
-		RegistrantContact: contactModelToAWS(data.RegistrantContact),
-		TechContact:       contactModelToAWS(data.TechContact),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating contacts",
-			fmt.Sprintf("Could not update contacts for %s: %s", domainName, err.Error()),
-		)
-		return
+	// Update privacy settings if changed
+	privacyInput := &route53domains.UpdateDomainContactPrivacyInput{
+		DomainName: aws.String(domainName),
+	}
+	if !data.AdminPrivacy.Equal(state.AdminPrivacy) {
+		privacyInput.AdminPrivacy = aws.Bool(data.AdminPrivacy.ValueBool())
+	}
+	if !data.RegistrantPrivacy.Equal(state.RegistrantPrivacy) {
+		privacyInput.RegistrantPrivacy = aws.Bool(data.RegistrantPrivacy.ValueBool())
+	}
+	if !data.TechPrivacy.Equal(state.TechPrivacy) {
+		privacyInput.TechPrivacy = aws.Bool(data.TechPrivacy.ValueBool())
+	}
+	// Removing billing_privacy leaves the AWS setting in place
+	if !data.BillingPrivacy.IsNull() && !data.BillingPrivacy.Equal(state.BillingPrivacy) {
+		privacyInput.BillingPrivacy = aws.Bool(data.BillingPrivacy.ValueBool())
+	}
+	if privacyInput.AdminPrivacy != nil || privacyInput.RegistrantPrivacy != nil || privacyInput.TechPrivacy != nil || privacyInput.BillingPrivacy != nil {
+		_, err := r.client.UpdateDomainContactPrivacy(ctx, privacyInput)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, data,
+				"Error updating privacy settings",
+				fmt.Sprintf("Could not update privacy settings for %s: %s", domainName, err.Error()),
+				err,
+			)
+			return
+		}
 	}
 
-	// Update privacy settings
-	_, err = r.client.UpdateDomainContactPrivacy(ctx, &route53domains.UpdateDomainContactPrivacyInput{
-		DomainName:        aws.String(domainName),
-		AdminPrivacy:      aws.Bool(data.AdminPrivacy.ValueBool()),
-		RegistrantPrivacy: aws.Bool(data.RegistrantPrivacy.ValueBool()),
-		TechPrivacy:       aws.Bool(data.TechPrivacy.ValueBool()),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating privacy settings",
-			fmt.Sprintf("Could not update privacy settings for %s: %s", domainName, err.Error()),
-		)
-		return
+	// Resend the registrant verification email when toggled on
+	if data.ResendReachabilityEmail.ValueBool() && !state.ResendReachabilityEmail.ValueBool() {
+		if err := r.resendReachabilityEmail(ctx, domainName); err != nil {
+			resp.Diagnostics.AddError(
+				"Error resending contact verification email",
+				fmt.Sprintf("Could not resend the contact reachability email for %s: %s", domainName, err.Error()),
+			)
+			return
+		}
 	}
 
 	// Refresh state
@@ -709,15 +2931,44 @@ func (r *DomainRegistrationResource) Update(ctx context.Context, req resource.Up
 	}
 
 	data.ID = tftypes.StringValue(domainName)
-	if domainDetail.ExpirationDate != nil {
-		data.ExpirationDate = tftypes.StringValue(domainDetail.ExpirationDate.Format(time.RFC3339))
-	}
-	if domainDetail.CreationDate != nil {
-		data.CreationDate = tftypes.StringValue(domainDetail.CreationDate.Format(time.RFC3339))
-	}
+	setDomainDates(&data, domainDetail)
 	if len(domainDetail.StatusList) > 0 {
 		data.Status = tftypes.StringValue(string(domainDetail.StatusList[0]))
 	}
+	setRegistrarInfo(&data, domainDetail)
+	setContactHashes(&data, domainDetail)
+	r.setPrivacyEffective(&data, domainDetail)
+	data.ReachabilityStatus = r.readReachabilityStatus(ctx, domainName)
+
+	// Look up the hosted zone ID if it was not carried over from state
+	if !manageHostedZone(data) {
+		data.HostedZoneID = tftypes.StringNull()
+	} else if data.HostedZoneID.IsUnknown() {
+		data.HostedZoneID = tftypes.StringNull()
+		if hostedZoneID, err := r.findHostedZoneID(ctx, domainName); err == nil {
+			data.HostedZoneID = tftypes.StringValue(hostedZoneID)
+		}
+	}
+	if !manageHostedZone(data) || data.RegistrarNameservers.IsUnknown() {
+		data.RegistrarNameservers = r.registrarNameservers(ctx, domainName, data.HostedZoneID)
+	}
+
+	// Tag the hosted zone if the tags changed or drifted. State written
+	// before tags_all existed only records tags.
+	data.TagsAll = tagsAllValue(mergeTags(r.defaultTags, data.Tags))
+	tags, prior := tagsAllMap(data.TagsAll), tagsAllMap(state.TagsAll)
+	if state.TagsAll.IsNull() {
+		prior = state.Tags
+	}
+	if !data.HostedZoneID.IsNull() && !maps.EqualFunc(tags, prior, func(x, y tftypes.String) bool { return x.Equal(y) }) {
+		if err := r.tagHostedZone(ctx, data.HostedZoneID.ValueString(), tags, prior); err != nil {
+			resp.Diagnostics.AddError(
+				"Error tagging hosted zone",
+				fmt.Sprintf("Could not update the tags of the hosted zone for %s: %s", domainName, err.Error()),
+			)
+			return
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -730,7 +2981,7 @@ func (r *DomainRegistrationResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	domainName := data.DomainName.ValueString()
+	domainName := canonicalDomainName(data.DomainName.ValueString())
 
 	// Check if deletion is allowed
 	if !data.AllowDelete.ValueBool() {
@@ -745,31 +2996,99 @@ func (r *DomainRegistrationResource) Delete(ctx context.Context, req resource.De
 		"domain": domainName,
 	})
 
+	// State written before delete_timeout existed has no value
+	deleteTimeout := data.DeleteTimeout.ValueInt64()
+	if data.DeleteTimeout.IsNull() {
+		deleteTimeout = defaultDeleteTimeout
+	}
+	timeout := time.Duration(deleteTimeout) * time.Second
+
+	if data.UnlockBeforeDelete.ValueBool() {
+		if err := r.disableTransferLock(ctx, domainName, timeout); err != nil {
+			resp.Diagnostics.AddError(
+				"Error unlocking domain",
+				fmt.Sprintf("Could not remove the transfer lock from %s before deletion: %s. The domain has not been deleted.", domainName, err.Error()),
+			)
+			return
+		}
+	}
+
 	// Attempt to delete the domain
-	_, err := r.client.DeleteDomain(ctx, &route53domains.DeleteDomainInput{
+	deleteOutput, err := r.client.DeleteDomain(ctx, &route53domains.DeleteDomainInput{
 		DomainName: aws.String(domainName),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		note := "Domain deletion may not be supported by the registry."
+		if data.Registrar.ValueString() == registrarGandi {
+			note = "The domain is registered through Gandi, which does not support deletion for every TLD; disable auto_renew and let it expire instead."
+		}
+		addAPIError(&resp.Diagnostics, data,
 			"Error deleting domain",
-			fmt.Sprintf("Could not delete domain %s: %s. Note: Domain deletion may not be supported by the registry. The domain has been removed from Terraform state.", domainName, err.Error()),
+			fmt.Sprintf("Could not delete domain %s: %s. Note: %s The domain has been removed from Terraform state.", domainName, err.Error(), note),
+			err,
 		)
 		// Still remove from state even if delete fails
 		return
 	}
 
 	tflog.Info(ctx, "Domain deletion initiated", map[string]interface{}{
-		"domain": domainName,
+		"domain":       domainName,
+		"operation_id": aws.ToString(deleteOutput.OperationId),
 	})
 
+	// Wait for deletion to complete
+	if deleteOutput.OperationId != nil {
+		operationID := aws.ToString(deleteOutput.OperationId)
+		opDetail, err := waitForOperation(ctx, r.client, operationID, timeout)
+		switch {
+		case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+			resp.Diagnostics.AddError(
+				"Domain deletion interrupted",
+				fmt.Sprintf("Stopped waiting for deletion of %s (operation %s): %s. The deletion may still complete; check the operation status before retrying.", domainName, operationID, err.Error()),
+			)
+			return
+		case errors.Is(err, errWaitTimeout):
+			// No poll may have succeeded if every one was throttled
+			status := types.OperationStatusSubmitted
+			if opDetail != nil {
+				status = opDetail.Status
+			}
+			resp.Diagnostics.AddWarning(
+				"Domain deletion still in progress",
+				fmt.Sprintf("Deletion of %s did not complete within %s. Operation %s is still %s; check it with the awsdomains_operation data source. The domain has been removed from Terraform state.", domainName, waitLimit(err, timeout), operationID, status),
+			)
+			return
+		case err != nil:
+			resp.Diagnostics.AddError(
+				"Error checking deletion status",
+				fmt.Sprintf("Could not check deletion status for %s: %s", domainName, err.Error()),
+			)
+			return
+		case opDetail.Status != types.OperationStatusSuccessful:
+			resp.Diagnostics.AddError(
+				"Domain deletion failed",
+				fmt.Sprintf("Deletion of %s finished with status %s: %s", domainName, opDetail.Status, aws.ToString(opDetail.Message)),
+			)
+			return
+		}
+	}
+
+	if !manageHostedZone(data) {
+		return
+	}
+
 	// Attempt to delete the registrar-created hosted zone (safe - only deletes if all safeguards pass)
-	err = r.deleteRegistrarHostedZone(ctx, domainName)
+	err = r.deleteRegistrarHostedZone(ctx, domainName, registrarZoneComments(data), data.DeletableZoneRecords)
 	if err != nil {
 		tflog.Warn(ctx, "Could not delete hosted zone", map[string]interface{}{
 			"domain": domainName,
 			"error":  err.Error(),
 		})
-		// Don't fail the destroy - domain is already deleted, zone cleanup is best-effort
+		// Don't fail the destroy - domain is already deleted, zone cleanup is
+		// best-effort. A zone that is already gone needs no warning.
+		if !errors.Is(err, errHostedZoneNotFound) {
+			addZoneDeletionWarning(&resp.Diagnostics, domainName, err)
+		}
 	} else {
 		tflog.Info(ctx, "Hosted zone deleted", map[string]interface{}{
 			"domain": domainName,
@@ -778,6 +3097,124 @@ func (r *DomainRegistrationResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *DomainRegistrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("domain_name"), req, resp)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	domainName, values, err := parseImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Could not parse import ID %q: %s. Use the domain name, optionally followed by comma-separated settings such as example.com,allow_delete=true,manage_hosted_zone=false.", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_name"), domainName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), canonicalDomainName(domainName))...)
+	for name, value := range values {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importDefaults returns the schema defaults that Read cannot recover from
+// AWS, seeded on import so a configuration relying on defaults has a clean
+// first plan. duration_years is left null: the original registration period
+// is not known, and a null state is never treated as a renewal.
+func importDefaults() map[string]interface{} {
+	return map[string]interface{}{
+		"allow_delete":              false,
+		"delete_hosted_zone":        false,
+		"manage_hosted_zone":        true,
+		"registrar_zone_comments":   []string{registrarZoneComment},
+		"registration_timeout":      int64(900),
+		"delete_timeout":            int64(defaultDeleteTimeout),
+		"unlock_before_delete":      false,
+		"resend_reachability_email": false,
+		"validate_availability":     false,
+		"allow_registrant_change":   false,
+		"skip_detail_refresh":       false,
+	}
+}
+
+// parseImportID splits an import ID of the form
+// "example.com[,name=value...]" into the domain name and the values to seed,
+// which are importDefaults overridden by the given settings. Any boolean or
+// number among the defaults can be set, so a configuration that differs from
+// them also imports without a diff.
+func parseImportID(id string) (string, map[string]interface{}, error) {
+	parts := strings.Split(id, ",")
+	domainName := strings.TrimSpace(parts[0])
+	if domainName == "" {
+		return "", nil, errors.New("missing domain name")
+	}
+
+	values := importDefaults()
+	for _, part := range parts[1:] {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return "", nil, fmt.Errorf("setting %q is not of the form name=value", part)
+		}
+		var err error
+		switch values[name].(type) {
+		case bool:
+			values[name], err = strconv.ParseBool(value)
+		case int64:
+			values[name], err = strconv.ParseInt(value, 10, 64)
+		default:
+			return "", nil, fmt.Errorf("%q cannot be set on import", name)
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid value %q for %s", value, name)
+		}
+	}
+	return domainName, values, nil
+}
+
+// UpgradeState migrates state from schema version 0, where nameservers was a
+// plain list of hostnames, to version 1, where each nameserver is an object
+// with a name and optional glue IPs.
+func (r *DomainRegistrationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeDomainStateV0toV1,
+		},
+	}
+}
+
+func upgradeDomainStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError(
+			"Unable to upgrade state",
+			"Prior state for awsdomains_domain is empty.",
+		)
+		return
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to upgrade state",
+			"Could not parse prior state: "+err.Error(),
+		)
+		return
+	}
+
+	if names, ok := rawState["nameservers"].([]interface{}); ok {
+		nameservers := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			nameservers = append(nameservers, map[string]interface{}{
+				"name":     name,
+				"glue_ips": nil,
+			})
+		}
+		rawState["nameservers"] = nameservers
+	}
+
+	upgraded, err := json.Marshal(rawState)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to upgrade state",
+			"Could not encode upgraded state: "+err.Error(),
+		)
+		return
+	}
+
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
 }
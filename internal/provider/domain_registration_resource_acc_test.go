@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccDomainRegistration_sandboxLifecycle runs create, update and destroy
+// through Terraform against the in-memory fake backend, so it registers
+// nothing and needs no AWS account.
+func TestAccDomainRegistration_sandboxLifecycle(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+	defer setOperationPollInterval(10 * time.Millisecond)()
+	isolateAWSEnv(t, "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	fake := newFakeRoute53Domains(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if _, ok := fake.Domain("example.com"); ok {
+				return fmt.Errorf("example.com is still registered")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainSandboxConfig(fake.URL(), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awsdomains_domain.test", "id", "example.com"),
+					resource.TestCheckResourceAttr("awsdomains_domain.test", "auto_renew", "false"),
+					resource.TestCheckResourceAttrSet("awsdomains_domain.test", "expiration_date"),
+				),
+			},
+			{
+				Config: testAccDomainSandboxConfig(fake.URL(), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("awsdomains_domain.test", "auto_renew", "true"),
+					func(*terraform.State) error {
+						if detail, _ := fake.Domain("example.com"); !aws.ToBool(detail.AutoRenew) {
+							return fmt.Errorf("auto-renew was not enabled on the backend")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccDomainSandboxConfig renders a configuration pointed at the fake
+// backend with a deletable domain and no hosted zone management.
+func testAccDomainSandboxConfig(endpoint string, autoRenew bool) string {
+	return fmt.Sprintf(`
+provider "awsdomains" {
+  route53domains_endpoint = %q
+}
+
+resource "awsdomains_domain" "test" {
+  domain_name        = "example.com"
+  auto_renew         = %t
+  allow_delete       = true
+  manage_hosted_zone = false
+
+  contact = {
+    first_name     = "Sandbox"
+    last_name      = "Test"
+    email          = "sandbox-test@example.com"
+    phone_number   = "+1.5555555555"
+    address_line_1 = "123 Main St"
+    city           = "Seattle"
+    state          = "WA"
+    country_code   = "US"
+    zip_code       = "98101"
+  }
+}
+`, endpoint, autoRenew)
+}
+
+// TestAccDomainRegistration_import imports an already registered domain named
+// by AWSDOMAINS_IMPORT_DOMAIN and asserts the following plan is empty. The
+// domain must be registered with the defaults: auto-renew off and privacy
+// protection on for every contact. Contacts are ignored since their privacy
+// protected values cannot be read back.
+func TestAccDomainRegistration_import(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+	domainName := os.Getenv("AWSDOMAINS_IMPORT_DOMAIN")
+	if domainName == "" {
+		t.Skip("AWSDOMAINS_IMPORT_DOMAIN must be set to an existing domain to run the import test")
+	}
+
+	cfg := testAccDomainImportConfig(domainName)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ResourceName:       "awsdomains_domain.test",
+				ImportState:        true,
+				ImportStateId:      domainName,
+				ImportStatePersist: true,
+				Config:             cfg,
+			},
+			{
+				Config:   cfg,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "awsdomains_domain.test",
+				ImportState:       true,
+				ImportStateId:     domainName,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"contact",
+					"admin_contact",
+					"registrant_contact",
+					"tech_contact",
+				},
+				Config: cfg,
+			},
+		},
+	})
+}
+
+// testAccDomainImportConfig renders a configuration relying on the schema
+// defaults for everything but the domain name and a placeholder contact.
+func testAccDomainImportConfig(domainName string) string {
+	return fmt.Sprintf(`
+provider "awsdomains" {}
+
+resource "awsdomains_domain" "test" {
+  domain_name = %q
+
+  contact = {
+    first_name     = "Import"
+    last_name      = "Test"
+    email          = "import-test@example.com"
+    phone_number   = "+1.5555555555"
+    address_line_1 = "123 Main St"
+    city           = "Seattle"
+    state          = "WA"
+    country_code   = "US"
+    zip_code       = "98101"
+  }
+
+  lifecycle {
+    ignore_changes = [contact, admin_contact, registrant_contact, tech_contact]
+  }
+}
+`, domainName)
+}
@@ -2,23 +2,286 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
-// MockRoute53DomainsClient is a mock implementation for testing
+// MockRoute53DomainsClient is a mock implementation for testing. Methods
+// without a configured func return an empty output and no error.
 type MockRoute53DomainsClient struct {
-	GetDomainDetailFunc         func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error)
-	RegisterDomainFunc          func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error)
-	GetOperationDetailFunc      func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error)
-	UpdateDomainNameserversFunc func(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error)
-	CheckDomainAvailabilityFunc func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error)
+	AcceptDomainTransferFromAnotherAwsAccountFunc func(ctx context.Context, params *route53domains.AcceptDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput, error)
+	AssociateDelegationSignerToDomainFunc         func(ctx context.Context, params *route53domains.AssociateDelegationSignerToDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.AssociateDelegationSignerToDomainOutput, error)
+	CancelDomainTransferToAnotherAwsAccountFunc   func(ctx context.Context, params *route53domains.CancelDomainTransferToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.CancelDomainTransferToAnotherAwsAccountOutput, error)
+	CheckDomainAvailabilityFunc                   func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error)
+	DeleteDomainFunc                              func(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error)
+	DisableDomainAutoRenewFunc                    func(ctx context.Context, params *route53domains.DisableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainAutoRenewOutput, error)
+	DisableDomainTransferLockFunc                 func(ctx context.Context, params *route53domains.DisableDomainTransferLockInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainTransferLockOutput, error)
+	DisassociateDelegationSignerFromDomainFunc    func(ctx context.Context, params *route53domains.DisassociateDelegationSignerFromDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DisassociateDelegationSignerFromDomainOutput, error)
+	EnableDomainAutoRenewFunc                     func(ctx context.Context, params *route53domains.EnableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.EnableDomainAutoRenewOutput, error)
+	GetContactReachabilityStatusFunc              func(ctx context.Context, params *route53domains.GetContactReachabilityStatusInput, optFns ...func(*route53domains.Options)) (*route53domains.GetContactReachabilityStatusOutput, error)
+	GetDomainDetailFunc                           func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error)
+	GetOperationDetailFunc                        func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error)
+	ListDomainsFunc                               func(ctx context.Context, params *route53domains.ListDomainsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListDomainsOutput, error)
+	ListOperationsFunc                            func(ctx context.Context, params *route53domains.ListOperationsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListOperationsOutput, error)
+	ListPricesFunc                                func(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error)
+	RegisterDomainFunc                            func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error)
+	RejectDomainTransferFromAnotherAwsAccountFunc func(ctx context.Context, params *route53domains.RejectDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.RejectDomainTransferFromAnotherAwsAccountOutput, error)
+	RenewDomainFunc                               func(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error)
+	ResendContactReachabilityEmailFunc            func(ctx context.Context, params *route53domains.ResendContactReachabilityEmailInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendContactReachabilityEmailOutput, error)
+	ResendOperationAuthorizationFunc              func(ctx context.Context, params *route53domains.ResendOperationAuthorizationInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendOperationAuthorizationOutput, error)
+	TransferDomainToAnotherAwsAccountFunc         func(ctx context.Context, params *route53domains.TransferDomainToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.TransferDomainToAnotherAwsAccountOutput, error)
+	UpdateDomainContactFunc                       func(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error)
+	UpdateDomainContactPrivacyFunc                func(ctx context.Context, params *route53domains.UpdateDomainContactPrivacyInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactPrivacyOutput, error)
+	UpdateDomainNameserversFunc                   func(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error)
+}
+
+var _ Route53DomainsAPI = &MockRoute53DomainsClient{}
+
+func (m *MockRoute53DomainsClient) AcceptDomainTransferFromAnotherAwsAccount(ctx context.Context, params *route53domains.AcceptDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput, error) {
+	if m.AcceptDomainTransferFromAnotherAwsAccountFunc != nil {
+		return m.AcceptDomainTransferFromAnotherAwsAccountFunc(ctx, params, optFns...)
+	}
+	return &route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) AssociateDelegationSignerToDomain(ctx context.Context, params *route53domains.AssociateDelegationSignerToDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.AssociateDelegationSignerToDomainOutput, error) {
+	if m.AssociateDelegationSignerToDomainFunc != nil {
+		return m.AssociateDelegationSignerToDomainFunc(ctx, params, optFns...)
+	}
+	return &route53domains.AssociateDelegationSignerToDomainOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) CancelDomainTransferToAnotherAwsAccount(ctx context.Context, params *route53domains.CancelDomainTransferToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.CancelDomainTransferToAnotherAwsAccountOutput, error) {
+	if m.CancelDomainTransferToAnotherAwsAccountFunc != nil {
+		return m.CancelDomainTransferToAnotherAwsAccountFunc(ctx, params, optFns...)
+	}
+	return &route53domains.CancelDomainTransferToAnotherAwsAccountOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) CheckDomainAvailability(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error) {
+	if m.CheckDomainAvailabilityFunc != nil {
+		return m.CheckDomainAvailabilityFunc(ctx, params, optFns...)
+	}
+	return &route53domains.CheckDomainAvailabilityOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) DeleteDomain(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error) {
+	if m.DeleteDomainFunc != nil {
+		return m.DeleteDomainFunc(ctx, params, optFns...)
+	}
+	return &route53domains.DeleteDomainOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) DisableDomainAutoRenew(ctx context.Context, params *route53domains.DisableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainAutoRenewOutput, error) {
+	if m.DisableDomainAutoRenewFunc != nil {
+		return m.DisableDomainAutoRenewFunc(ctx, params, optFns...)
+	}
+	return &route53domains.DisableDomainAutoRenewOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) DisableDomainTransferLock(ctx context.Context, params *route53domains.DisableDomainTransferLockInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainTransferLockOutput, error) {
+	if m.DisableDomainTransferLockFunc != nil {
+		return m.DisableDomainTransferLockFunc(ctx, params, optFns...)
+	}
+	return &route53domains.DisableDomainTransferLockOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) DisassociateDelegationSignerFromDomain(ctx context.Context, params *route53domains.DisassociateDelegationSignerFromDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DisassociateDelegationSignerFromDomainOutput, error) {
+	if m.DisassociateDelegationSignerFromDomainFunc != nil {
+		return m.DisassociateDelegationSignerFromDomainFunc(ctx, params, optFns...)
+	}
+	return &route53domains.DisassociateDelegationSignerFromDomainOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) EnableDomainAutoRenew(ctx context.Context, params *route53domains.EnableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.EnableDomainAutoRenewOutput, error) {
+	if m.EnableDomainAutoRenewFunc != nil {
+		return m.EnableDomainAutoRenewFunc(ctx, params, optFns...)
+	}
+	return &route53domains.EnableDomainAutoRenewOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) GetContactReachabilityStatus(ctx context.Context, params *route53domains.GetContactReachabilityStatusInput, optFns ...func(*route53domains.Options)) (*route53domains.GetContactReachabilityStatusOutput, error) {
+	if m.GetContactReachabilityStatusFunc != nil {
+		return m.GetContactReachabilityStatusFunc(ctx, params, optFns...)
+	}
+	return &route53domains.GetContactReachabilityStatusOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) GetDomainDetail(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+	if m.GetDomainDetailFunc != nil {
+		return m.GetDomainDetailFunc(ctx, params, optFns...)
+	}
+	return &route53domains.GetDomainDetailOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) GetOperationDetail(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+	if m.GetOperationDetailFunc != nil {
+		return m.GetOperationDetailFunc(ctx, params, optFns...)
+	}
+	return &route53domains.GetOperationDetailOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) ListDomains(ctx context.Context, params *route53domains.ListDomainsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListDomainsOutput, error) {
+	if m.ListDomainsFunc != nil {
+		return m.ListDomainsFunc(ctx, params, optFns...)
+	}
+	return &route53domains.ListDomainsOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) ListOperations(ctx context.Context, params *route53domains.ListOperationsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListOperationsOutput, error) {
+	if m.ListOperationsFunc != nil {
+		return m.ListOperationsFunc(ctx, params, optFns...)
+	}
+	return &route53domains.ListOperationsOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) ListPrices(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error) {
+	if m.ListPricesFunc != nil {
+		return m.ListPricesFunc(ctx, params, optFns...)
+	}
+	return &route53domains.ListPricesOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) RegisterDomain(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+	if m.RegisterDomainFunc != nil {
+		return m.RegisterDomainFunc(ctx, params, optFns...)
+	}
+	return &route53domains.RegisterDomainOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) RejectDomainTransferFromAnotherAwsAccount(ctx context.Context, params *route53domains.RejectDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.RejectDomainTransferFromAnotherAwsAccountOutput, error) {
+	if m.RejectDomainTransferFromAnotherAwsAccountFunc != nil {
+		return m.RejectDomainTransferFromAnotherAwsAccountFunc(ctx, params, optFns...)
+	}
+	return &route53domains.RejectDomainTransferFromAnotherAwsAccountOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) RenewDomain(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error) {
+	if m.RenewDomainFunc != nil {
+		return m.RenewDomainFunc(ctx, params, optFns...)
+	}
+	return &route53domains.RenewDomainOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) ResendContactReachabilityEmail(ctx context.Context, params *route53domains.ResendContactReachabilityEmailInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendContactReachabilityEmailOutput, error) {
+	if m.ResendContactReachabilityEmailFunc != nil {
+		return m.ResendContactReachabilityEmailFunc(ctx, params, optFns...)
+	}
+	return &route53domains.ResendContactReachabilityEmailOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) ResendOperationAuthorization(ctx context.Context, params *route53domains.ResendOperationAuthorizationInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendOperationAuthorizationOutput, error) {
+	if m.ResendOperationAuthorizationFunc != nil {
+		return m.ResendOperationAuthorizationFunc(ctx, params, optFns...)
+	}
+	return &route53domains.ResendOperationAuthorizationOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) TransferDomainToAnotherAwsAccount(ctx context.Context, params *route53domains.TransferDomainToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.TransferDomainToAnotherAwsAccountOutput, error) {
+	if m.TransferDomainToAnotherAwsAccountFunc != nil {
+		return m.TransferDomainToAnotherAwsAccountFunc(ctx, params, optFns...)
+	}
+	return &route53domains.TransferDomainToAnotherAwsAccountOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) UpdateDomainContact(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error) {
+	if m.UpdateDomainContactFunc != nil {
+		return m.UpdateDomainContactFunc(ctx, params, optFns...)
+	}
+	return &route53domains.UpdateDomainContactOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) UpdateDomainContactPrivacy(ctx context.Context, params *route53domains.UpdateDomainContactPrivacyInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactPrivacyOutput, error) {
+	if m.UpdateDomainContactPrivacyFunc != nil {
+		return m.UpdateDomainContactPrivacyFunc(ctx, params, optFns...)
+	}
+	return &route53domains.UpdateDomainContactPrivacyOutput{}, nil
+}
+
+func (m *MockRoute53DomainsClient) UpdateDomainNameservers(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error) {
+	if m.UpdateDomainNameserversFunc != nil {
+		return m.UpdateDomainNameserversFunc(ctx, params, optFns...)
+	}
+	return &route53domains.UpdateDomainNameserversOutput{}, nil
+}
+
+// MockRoute53Client is a mock implementation for testing. Methods without a
+// configured func return an empty output and no error.
+type MockRoute53Client struct {
+	ChangeResourceRecordSetsFunc func(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error)
+	ChangeTagsForResourceFunc    func(ctx context.Context, params *route53.ChangeTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ChangeTagsForResourceOutput, error)
+	DeleteHostedZoneFunc         func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error)
+	ListHostedZonesByNameFunc    func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error)
+	ListResourceRecordSetsFunc   func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error)
+	ListTagsForResourceFunc      func(ctx context.Context, params *route53.ListTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ListTagsForResourceOutput, error)
+}
+
+var _ Route53API = &MockRoute53Client{}
+
+func (m *MockRoute53Client) ChangeResourceRecordSets(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+	if m.ChangeResourceRecordSetsFunc != nil {
+		return m.ChangeResourceRecordSetsFunc(ctx, params, optFns...)
+	}
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func (m *MockRoute53Client) ChangeTagsForResource(ctx context.Context, params *route53.ChangeTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ChangeTagsForResourceOutput, error) {
+	if m.ChangeTagsForResourceFunc != nil {
+		return m.ChangeTagsForResourceFunc(ctx, params, optFns...)
+	}
+	return &route53.ChangeTagsForResourceOutput{}, nil
+}
+
+func (m *MockRoute53Client) DeleteHostedZone(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+	if m.DeleteHostedZoneFunc != nil {
+		return m.DeleteHostedZoneFunc(ctx, params, optFns...)
+	}
+	return &route53.DeleteHostedZoneOutput{}, nil
+}
+
+func (m *MockRoute53Client) ListHostedZonesByName(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+	if m.ListHostedZonesByNameFunc != nil {
+		return m.ListHostedZonesByNameFunc(ctx, params, optFns...)
+	}
+	return &route53.ListHostedZonesByNameOutput{}, nil
+}
+
+func (m *MockRoute53Client) ListResourceRecordSets(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+	if m.ListResourceRecordSetsFunc != nil {
+		return m.ListResourceRecordSetsFunc(ctx, params, optFns...)
+	}
+	return &route53.ListResourceRecordSetsOutput{}, nil
+}
+
+func (m *MockRoute53Client) ListTagsForResource(ctx context.Context, params *route53.ListTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ListTagsForResourceOutput, error) {
+	if m.ListTagsForResourceFunc != nil {
+		return m.ListTagsForResourceFunc(ctx, params, optFns...)
+	}
+	return &route53.ListTagsForResourceOutput{}, nil
 }
 
 func TestResourceSchema(t *testing.T) {
@@ -39,6 +302,7 @@ func TestResourceSchema(t *testing.T) {
 		"domain_name",
 		"duration_years",
 		"auto_renew",
+		"contact",
 		"admin_contact",
 		"registrant_contact",
 		"tech_contact",
@@ -116,6 +380,42 @@ func TestContactModelToAWS(t *testing.T) {
 				ContactType:  types.ContactTypePerson,
 			},
 		},
+		{
+			name: "company contact",
+			input: &ContactModel{
+				Organization: stringValue("Example Corp"),
+				Email:        stringValue("hostmaster@example.com"),
+				CountryCode:  stringValue("US"),
+				ContactType:  stringValue("COMPANY"),
+			},
+			expected: &types.ContactDetail{
+				OrganizationName: aws.String("Example Corp"),
+				Email:            aws.String("hostmaster@example.com"),
+				CountryCode:      types.CountryCodeUs,
+				ContactType:      types.ContactTypeCompany,
+			},
+		},
+		{
+			name: "extra params",
+			input: &ContactModel{
+				Email:       stringValue("jane@example.ca"),
+				CountryCode: stringValue("CA"),
+				ExtraParams: map[string]tftypes.String{
+					"CA_LEGAL_TYPE":   stringValue("CCO"),
+					"BRAND_NUMBER":    stringValue("123"),
+					"DOCUMENT_NUMBER": stringValue("A1"),
+				},
+			},
+			expected: &types.ContactDetail{
+				Email:       aws.String("jane@example.ca"),
+				CountryCode: types.CountryCodeCa,
+				ExtraParams: []types.ExtraParam{
+					{Name: types.ExtraParamNameBrandNumber, Value: aws.String("123")},
+					{Name: types.ExtraParamNameCaLegalType, Value: aws.String("CCO")},
+					{Name: types.ExtraParamNameDocumentNumber, Value: aws.String("A1")},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -136,13 +436,69 @@ func TestContactModelToAWS(t *testing.T) {
 			if aws.ToString(result.LastName) != aws.ToString(tt.expected.LastName) {
 				t.Errorf("LastName mismatch: got %s, want %s", aws.ToString(result.LastName), aws.ToString(tt.expected.LastName))
 			}
+			if aws.ToString(result.OrganizationName) != aws.ToString(tt.expected.OrganizationName) {
+				t.Errorf("OrganizationName mismatch: got %s, want %s", aws.ToString(result.OrganizationName), aws.ToString(tt.expected.OrganizationName))
+			}
 			if aws.ToString(result.Email) != aws.ToString(tt.expected.Email) {
 				t.Errorf("Email mismatch: got %s, want %s", aws.ToString(result.Email), aws.ToString(tt.expected.Email))
 			}
+			if !reflect.DeepEqual(result.ExtraParams, tt.expected.ExtraParams) {
+				t.Errorf("ExtraParams mismatch: got %+v, want %+v", result.ExtraParams, tt.expected.ExtraParams)
+			}
 		})
 	}
 }
 
+func TestContactHash(t *testing.T) {
+	contact := func() *types.ContactDetail {
+		return &types.ContactDetail{
+			FirstName:   aws.String("John"),
+			LastName:    aws.String("Doe"),
+			Email:       aws.String("john@example.com"),
+			CountryCode: types.CountryCodeUs,
+			ContactType: types.ContactTypePerson,
+			ExtraParams: []types.ExtraParam{
+				{Name: types.ExtraParamNameBrandNumber, Value: aws.String("1")},
+				{Name: types.ExtraParamNameDocumentNumber, Value: aws.String("2")},
+			},
+		}
+	}
+	base := contactHash(contact(), false)
+	if base.IsNull() || len(base.ValueString()) != 64 {
+		t.Fatalf("Expected a hex SHA-256 hash, got %s", base)
+	}
+	if got := contactHash(contact(), false); !got.Equal(base) {
+		t.Errorf("Expected a stable hash, got %s and %s", base, got)
+	}
+
+	changed := contact()
+	changed.Email = aws.String("jane@example.com")
+	if contactHash(changed, false).Equal(base) {
+		t.Error("Expected the hash to change when the email changes")
+	}
+
+	reordered := contact()
+	slices.Reverse(reordered.ExtraParams)
+	if !contactHash(reordered, false).Equal(base) {
+		t.Error("Expected the hash not to depend on extra param order")
+	}
+
+	// Redacted fields of a privacy-protected contact are not hashed
+	redacted := contact()
+	redacted.Email = aws.String("redacted@example.com")
+	if !contactHash(redacted, true).Equal(contactHash(contact(), true)) {
+		t.Error("Expected redacted fields to be ignored with privacy enabled")
+	}
+	redacted.CountryCode = types.CountryCodeCa
+	if contactHash(redacted, true).Equal(contactHash(contact(), true)) {
+		t.Error("Expected the country code to be hashed with privacy enabled")
+	}
+
+	if !contactHash(nil, false).IsNull() {
+		t.Error("Expected a null hash without a contact")
+	}
+}
+
 func TestContactTypeDefault(t *testing.T) {
 	// Test that empty contact type defaults to PERSON
 	input := &ContactModel{
@@ -164,45 +520,4171 @@ func TestContactTypeDefault(t *testing.T) {
 	}
 }
 
-// Helper to create terraform string values for testing
-func stringValue(s string) tftypes.String {
-	return tftypes.StringValue(s)
+func TestNameserversToAWSWithGlueIPs(t *testing.T) {
+	input := []NameserverModel{
+		{
+			Name:    stringValue("ns1.example.com"),
+			GlueIPs: []tftypes.String{stringValue("192.0.2.1"), stringValue("2001:db8::1")},
+		},
+		{
+			Name: stringValue("ns.external.net"),
+		},
+	}
+
+	result := nameserversToAWS(input)
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 nameservers, got %d", len(result))
+	}
+	if aws.ToString(result[0].Name) != "ns1.example.com" {
+		t.Errorf("Name mismatch: got %s, want ns1.example.com", aws.ToString(result[0].Name))
+	}
+	if len(result[0].GlueIps) != 2 || result[0].GlueIps[0] != "192.0.2.1" || result[0].GlueIps[1] != "2001:db8::1" {
+		t.Errorf("GlueIps mismatch: got %v", result[0].GlueIps)
+	}
+	if len(result[1].GlueIps) != 0 {
+		t.Errorf("Expected no glue IPs for external nameserver, got %v", result[1].GlueIps)
+	}
+
+	roundTrip := nameserversFromAWS(result)
+	if len(roundTrip[0].GlueIPs) != 2 || roundTrip[0].GlueIPs[1].ValueString() != "2001:db8::1" {
+		t.Errorf("Round-trip GlueIPs mismatch: got %v", roundTrip[0].GlueIPs)
+	}
+	if roundTrip[1].GlueIPs != nil {
+		t.Errorf("Expected nil glue IPs after round-trip, got %v", roundTrip[1].GlueIPs)
+	}
 }
 
-// MockDomainDetailResponse creates a mock GetDomainDetailOutput
-func MockDomainDetailResponse(domainName string) *route53domains.GetDomainDetailOutput {
-	now := time.Now()
-	expiry := now.AddDate(1, 0, 0)
-	return &route53domains.GetDomainDetailOutput{
-		DomainName:     aws.String(domainName),
-		AutoRenew:      aws.Bool(false),
-		CreationDate:   aws.Time(now),
-		ExpirationDate: aws.Time(expiry),
-		StatusList:     []string{"ok"},
-		Nameservers: []types.Nameserver{
-			{Name: aws.String("ns1.example.com")},
-			{Name: aws.String("ns2.example.com")},
+func TestUpgradeDomainStateV0toV1(t *testing.T) {
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"example.com","domain_name":"example.com","nameservers":["ns1.example.net","ns2.example.net"]}`),
 		},
-		AdminContact: &types.ContactDetail{
-			FirstName:   aws.String("John"),
-			LastName:    aws.String("Doe"),
-			Email:       aws.String("admin@example.com"),
-			PhoneNumber: aws.String("+1.5551234567"),
+	}
+	resp := &resource.UpgradeStateResponse{}
+
+	upgradeDomainStateV0toV1(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Upgrade returned errors: %v", resp.Diagnostics)
+	}
+	if resp.DynamicValue == nil {
+		t.Fatal("Expected upgraded DynamicValue")
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	NewDomainRegistrationResource().Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	if _, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(context.Background())); err != nil {
+		t.Fatalf("Upgraded state does not match current schema: %v", err)
+	}
+
+	var upgraded struct {
+		DomainName  string `json:"domain_name"`
+		Nameservers []struct {
+			Name    string   `json:"name"`
+			GlueIPs []string `json:"glue_ips"`
+		} `json:"nameservers"`
+	}
+	if err := json.Unmarshal(resp.DynamicValue.JSON, &upgraded); err != nil {
+		t.Fatalf("Could not parse upgraded state: %v", err)
+	}
+	if upgraded.DomainName != "example.com" {
+		t.Errorf("domain_name mismatch: got %s", upgraded.DomainName)
+	}
+	if len(upgraded.Nameservers) != 2 || upgraded.Nameservers[1].Name != "ns2.example.net" {
+		t.Errorf("nameservers not upgraded: got %+v", upgraded.Nameservers)
+	}
+}
+
+func TestCreate_contextCancelledDuringWait(t *testing.T) {
+	defer setOperationPollInterval(time.Hour)()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusInProgress}, nil
+			},
 		},
-		RegistrantContact: &types.ContactDetail{
-			FirstName:   aws.String("John"),
-			LastName:    aws.String("Doe"),
-			Email:       aws.String("registrant@example.com"),
-			PhoneNumber: aws.String("+1.5551234567"),
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainModel("example.com"))}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	r.Create(ctx, req, resp)
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Create did not return promptly after cancellation (took %s)", elapsed)
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error diagnostic")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Domain registration interrupted" {
+		t.Errorf("Unexpected diagnostic summary: %s", summary)
+	}
+
+	var operationID tftypes.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("operation_id"), &operationID)...)
+	if operationID.ValueString() != "op-register" {
+		t.Errorf("Expected operation_id op-register to be saved, got %s", operationID)
+	}
+}
+
+func TestCreate_missingOperationID(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				t.Error("GetOperationDetail should not be called without an operation ID")
+				return nil, nil
+			},
 		},
-		TechContact: &types.ContactDetail{
-			FirstName:   aws.String("John"),
-			LastName:    aws.String("Doe"),
-			Email:       aws.String("tech@example.com"),
-			PhoneNumber: aws.String("+1.5551234567"),
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainModel("example.com"))}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Missing registration operation ID" {
+		t.Fatalf("Expected a missing operation ID error, got %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected no state to be saved")
+	}
+}
+
+func TestCreate_resumesPendingRegistration(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var listInput *route53domains.ListOperationsInput
+	var polled []string
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			ListOperationsFunc: func(ctx context.Context, params *route53domains.ListOperationsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListOperationsOutput, error) {
+				listInput = params
+				return &route53domains.ListOperationsOutput{Operations: []types.OperationSummary{
+					{OperationId: aws.String("op-other"), DomainName: aws.String("example.net"), Type: types.OperationTypeRegisterDomain, Status: types.OperationStatusInProgress},
+					{OperationId: aws.String("op-earlier"), DomainName: aws.String("EXAMPLE.com"), Type: types.OperationTypeRegisterDomain, Status: types.OperationStatusInProgress},
+				}}, nil
+			},
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				t.Error("RegisterDomain should not be called while a registration is pending")
+				return nil, errors.New("domain is already being registered")
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				polled = append(polled, aws.ToString(params.OperationId))
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse("example.com"), nil
+			},
 		},
-		AdminPrivacy:      aws.Bool(true),
-		RegistrantPrivacy: aws.Bool(true),
-		TechPrivacy:       aws.Bool(true),
+		route53Client: &MockRoute53Client{},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainModel("example.com"))}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Resuming domain registration" {
+		t.Fatalf("Expected a resume warning, got %v", resp.Diagnostics)
+	}
+	if !slices.Equal(listInput.Type, []types.OperationType{types.OperationTypeRegisterDomain}) ||
+		!slices.Equal(listInput.Status, []types.OperationStatus{types.OperationStatusSubmitted, types.OperationStatusInProgress}) {
+		t.Errorf("Expected pending REGISTER_DOMAIN operations to be listed, got %+v", listInput)
+	}
+	if !slices.Equal(polled, []string{"op-earlier"}) {
+		t.Errorf("Expected the pending operation op-earlier to be polled, got %v", polled)
+	}
+
+	var state DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.ID.ValueString() != "example.com" || !state.OperationID.IsNull() {
+		t.Errorf("Expected a completed registration of example.com, got id %s, operation_id %s", state.ID, state.OperationID)
+	}
+}
+
+func TestCreate_alreadyRegisteredSuggestsImport(t *testing.T) {
+	owned := true
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "DomainLimitExceeded", Message: "domain already owned"}
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				if !owned {
+					return nil, &smithy.GenericAPIError{Code: "InvalidInput", Message: "domain not found"}
+				}
+				return MockDomainDetailResponse("example.com"), nil
+			},
+		},
+	}
+
+	for _, owned = range []bool{true, false} {
+		req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainModel("example.com"))}
+		resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+		r.Create(context.Background(), req, resp)
+
+		if resp.Diagnostics.ErrorsCount() != 1 {
+			t.Fatalf("Expected one error, got %v", resp.Diagnostics)
+		}
+		if got := strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "import it"); got != owned {
+			t.Errorf("With owned = %t, expected import suggestion %t, got %q", owned, owned, resp.Diagnostics.Errors()[0].Detail())
+		}
+	}
+}
+
+func TestCreate_timeoutRecordsPendingOperation(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Millisecond)()
+	submitted := time.Now().Add(-42 * time.Minute)
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{
+					Status:          types.OperationStatusInProgress,
+					SubmittedDate:   aws.Time(submitted),
+					LastUpdatedDate: aws.Time(submitted.Add(5 * time.Minute)),
+				}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				t.Error("GetDomainDetail should not be called while registration is pending")
+				return nil, nil
+			},
+		},
+	}
+
+	plan := testDomainModel("example.com")
+	plan.RegistrationTimeout = tftypes.Int64Value(1)
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Domain registration still in progress" {
+		t.Fatalf("Expected a pending registration warning, got %v", resp.Diagnostics)
+	}
+	// The registration waited about a second, so elapsed may have ticked over
+	detail := resp.Diagnostics.Warnings()[0].Detail()
+	if !regexp.MustCompile(`submitted at \S+ \(42m[0-9]s ago\) and last updated at `).MatchString(detail) {
+		t.Errorf("Expected the operation's submitted date and elapsed time, got %q", detail)
+	}
+
+	var state DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.OperationID.ValueString() != "op-register" {
+		t.Errorf("Expected operation_id op-register, got %s", state.OperationID)
+	}
+	if state.Status.ValueString() != "IN_PROGRESS" {
+		t.Errorf("Expected status IN_PROGRESS, got %s", state.Status)
+	}
+}
+
+func TestCreate_attemptCapRecordsPendingOperation(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	previous := operationMaxAttempts
+	operationMaxAttempts = 2
+	defer func() { operationMaxAttempts = previous }()
+
+	calls := 0
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				calls++
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusInProgress}, nil
+			},
+		},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainModel("example.com"))}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 polls, got %d", calls)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "within 2 status checks") {
+		t.Fatalf("Expected a warning naming the attempt cap, got %v", resp.Diagnostics)
+	}
+
+	var state DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.OperationID.ValueString() != "op-register" {
+		t.Errorf("Expected operation_id op-register, got %s", state.OperationID)
+	}
+}
+
+func TestCreate_timeoutsBlockOverridesRegistrationTimeout(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Millisecond)()
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusInProgress}, nil
+			},
+		},
+	}
+
+	plan := testDomainModel("example.com")
+	plan.RegistrationTimeout = tftypes.Int64Value(900)
+	plan.Timeouts = &TimeoutsModel{Create: tftypes.StringValue("50ms")}
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+
+	start := time.Now()
+	r.Create(context.Background(), req, resp)
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected timeouts.create to bound the wait, took %s", elapsed)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Domain registration still in progress" {
+		t.Fatalf("Expected a pending registration warning, got %v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Warnings()[0].Detail(); !strings.Contains(detail, "within 50ms") {
+		t.Errorf("Expected the warning to report the 50ms timeout, got %q", detail)
+	}
+}
+
+func TestRegistrationTimeout(t *testing.T) {
+	data := *testDomainModel("example.com")
+	data.RegistrationTimeout = tftypes.Int64Value(600)
+	if got := registrationTimeout(data); got != 10*time.Minute {
+		t.Errorf("Expected registration_timeout fallback of 10m, got %s", got)
+	}
+
+	data.Timeouts = &TimeoutsModel{Create: tftypes.StringNull()}
+	if got := registrationTimeout(data); got != 10*time.Minute {
+		t.Errorf("Expected fallback with an empty timeouts block, got %s", got)
+	}
+
+	data.Timeouts.Create = tftypes.StringValue("20m")
+	if got := registrationTimeout(data); got != 20*time.Minute {
+		t.Errorf("Expected timeouts.create of 20m, got %s", got)
+	}
+}
+
+func TestRead_pendingRegistration(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        types.OperationStatus
+		wantRemoved   bool
+		wantOperation bool
+	}{
+		{"still in progress", types.OperationStatusInProgress, false, true},
+		{"completed", types.OperationStatusSuccessful, false, false},
+		{"failed", types.OperationStatusFailed, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						if aws.ToString(params.OperationId) != "op-register" {
+							t.Errorf("Unexpected operation ID %s", aws.ToString(params.OperationId))
+						}
+						return &route53domains.GetOperationDetailOutput{Status: tt.status}, nil
+					},
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						return MockDomainDetailResponse(*params.DomainName), nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			prior.OperationID = stringValue("op-register")
+			prior.Status = stringValue("SUBMITTED")
+			state := newResourceState(t, r, prior)
+			req := resource.ReadRequest{State: state}
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("Expected removed=%v, got state %v", tt.wantRemoved, resp.State.Raw)
+			}
+			if tt.wantRemoved {
+				return
+			}
+
+			var operationID tftypes.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("operation_id"), &operationID)...)
+			if operationID.IsNull() == tt.wantOperation {
+				t.Errorf("Expected operation_id retained=%v, got %s", tt.wantOperation, operationID)
+			}
+		})
+	}
+}
+
+func TestRead_pendingRegistrationCompletedFinishesRegistration(t *testing.T) {
+	previous := hostedZonePollInterval
+	hostedZonePollInterval = time.Millisecond
+	defer func() { hostedZonePollInterval = previous }()
+
+	resent := false
+	var deleted string
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+			ResendContactReachabilityEmailFunc: func(ctx context.Context, params *route53domains.ResendContactReachabilityEmailInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendContactReachabilityEmailOutput, error) {
+				resent = true
+				return &route53domains.ResendContactReachabilityEmailOutput{}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{
+			ListHostedZonesByNameFunc: func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+				if deleted != "" {
+					return &route53.ListHostedZonesByNameOutput{}, nil
+				}
+				return &route53.ListHostedZonesByNameOutput{
+					HostedZones: []route53types.HostedZone{{
+						Id:   aws.String("/hostedzone/Z123"),
+						Name: aws.String("example.com."),
+						Config: &route53types.HostedZoneConfig{
+							Comment: aws.String("HostedZone created by Route53 Registrar"),
+						},
+					}},
+				}, nil
+			},
+			ListResourceRecordSetsFunc: func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+				return &route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []route53types.ResourceRecordSet{
+						{Name: aws.String("example.com."), Type: route53types.RRTypeNs},
+						{Name: aws.String("example.com."), Type: route53types.RRTypeSoa},
+					},
+				}, nil
+			},
+			DeleteHostedZoneFunc: func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+				deleted = aws.ToString(params.Id)
+				return &route53.DeleteHostedZoneOutput{}, nil
+			},
+		},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.OperationID = stringValue("op-register")
+	prior.Status = stringValue("SUBMITTED")
+	prior.DeleteHostedZone = tftypes.BoolValue(true)
+	prior.ResendReachabilityEmail = tftypes.BoolValue(true)
+	state := newResourceState(t, r, prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if !resent {
+		t.Error("Expected the reachability email to be resent once the registration completed")
+	}
+	if deleted != "/hostedzone/Z123" {
+		t.Errorf("Expected hosted zone /hostedzone/Z123 to be deleted, got %q", deleted)
+	}
+
+	var got DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if !got.OperationID.IsNull() {
+		t.Errorf("Expected operation_id to be cleared, got %s", got.OperationID)
+	}
+	if !got.HostedZoneID.IsNull() {
+		t.Errorf("Expected hosted_zone_id to be null after deletion, got %s", got.HostedZoneID)
+	}
+}
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		id         string
+		wantDomain string
+		wantValues map[string]interface{}
+		wantErr    bool
+	}{
+		{id: "example.com", wantDomain: "example.com"},
+		{
+			id:         "example.com,allow_delete=true,manage_hosted_zone=false",
+			wantDomain: "example.com",
+			wantValues: map[string]interface{}{"allow_delete": true, "manage_hosted_zone": false},
+		},
+		{
+			id:         "example.com, unlock_before_delete=true, delete_timeout=60",
+			wantDomain: "example.com",
+			wantValues: map[string]interface{}{"unlock_before_delete": true, "delete_timeout": int64(60)},
+		},
+		{id: "", wantErr: true},
+		{id: ",allow_delete=true", wantErr: true},
+		{id: "example.com,allow_delete", wantErr: true},
+		{id: "example.com,allow_delete=maybe", wantErr: true},
+		{id: "example.com,delete_timeout=soon", wantErr: true},
+		{id: "example.com,auto_renew=true", wantErr: true},
+		{id: "example.com,registrar_zone_comments=x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			domainName, values, err := parseImportID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error=%v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if domainName != tt.wantDomain {
+				t.Errorf("Expected domain %q, got %q", tt.wantDomain, domainName)
+			}
+			want := importDefaults()
+			maps.Copy(want, tt.wantValues)
+			if !reflect.DeepEqual(values, want) {
+				t.Errorf("Expected values %v, got %v", want, values)
+			}
+		})
+	}
+}
+
+func TestImportState_extendedID(t *testing.T) {
+	r := &DomainRegistrationResource{}
+	ctx := context.Background()
+	resp := &resource.ImportStateResponse{State: newResourceState(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "Example.com,allow_delete=true,manage_hosted_zone=false"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState returned errors: %v", resp.Diagnostics)
+	}
+
+	var got DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.DomainName.ValueString() != "Example.com" || got.ID.ValueString() != "example.com" {
+		t.Errorf("Expected domain_name Example.com and id example.com, got %s and %s", got.DomainName, got.ID)
+	}
+	if !got.AllowDelete.ValueBool() || got.ManageHostedZone.ValueBool() {
+		t.Errorf("Expected allow_delete true and manage_hosted_zone false, got %s and %s", got.AllowDelete, got.ManageHostedZone)
+	}
+	if got.DeleteHostedZone.ValueBool() || got.DeleteTimeout.ValueInt64() != defaultDeleteTimeout {
+		t.Errorf("Expected unlisted settings to keep their defaults, got delete_hosted_zone %s and delete_timeout %s", got.DeleteHostedZone, got.DeleteTimeout)
+	}
+
+	resp = &resource.ImportStateResponse{State: newResourceState(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "example.com,allow_delete=yes please"}, resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid import ID" {
+		t.Errorf("Expected an invalid import ID error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestImportState_hydratesDefaults(t *testing.T) {
+	detail := MockDomainDetailResponse("example.com")
+	detail.AdminPrivacy = aws.Bool(false)
+	detail.RegistrantPrivacy = aws.Bool(false)
+	detail.TechPrivacy = aws.Bool(false)
+	for _, c := range []*types.ContactDetail{detail.AdminContact, detail.RegistrantContact, detail.TechContact} {
+		c.AddressLine1 = aws.String("123 Main St")
+		c.City = aws.String("Seattle")
+		c.State = aws.String("WA")
+		c.ZipCode = aws.String("98101")
+		c.CountryCode = types.CountryCodeUs
+		c.ContactType = types.ContactTypePerson
+	}
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	ctx := context.Background()
+	importResp := &resource.ImportStateResponse{State: newResourceState(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "example.com"}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("ImportState returned errors: %v", importResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+
+	var got DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &got)...)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Could not read state: %v", readResp.Diagnostics)
+	}
+
+	// Everything a default configuration would plan must match the imported state
+	want := testDomainModel("example.com")
+	want.AdminPrivacy = tftypes.BoolValue(false)
+	want.RegistrantPrivacy = tftypes.BoolValue(false)
+	want.TechPrivacy = tftypes.BoolValue(false)
+
+	want.DurationYears = tftypes.Int64Null()
+
+	checks := map[string][2]attr.Value{
+		"duration_years":            {got.DurationYears, want.DurationYears},
+		"auto_renew":                {got.AutoRenew, want.AutoRenew},
+		"admin_privacy":             {got.AdminPrivacy, want.AdminPrivacy},
+		"registrant_privacy":        {got.RegistrantPrivacy, want.RegistrantPrivacy},
+		"tech_privacy":              {got.TechPrivacy, want.TechPrivacy},
+		"allow_delete":              {got.AllowDelete, want.AllowDelete},
+		"delete_hosted_zone":        {got.DeleteHostedZone, want.DeleteHostedZone},
+		"manage_hosted_zone":        {got.ManageHostedZone, want.ManageHostedZone},
+		"registration_timeout":      {got.RegistrationTimeout, want.RegistrationTimeout},
+		"delete_timeout":            {got.DeleteTimeout, want.DeleteTimeout},
+		"resend_reachability_email": {got.ResendReachabilityEmail, want.ResendReachabilityEmail},
+		"validate_availability":     {got.ValidateAvailability, want.ValidateAvailability},
+		"allow_registrant_change":   {got.AllowRegistrantChange, want.AllowRegistrantChange},
+		"skip_detail_refresh":       {got.SkipDetailRefresh, want.SkipDetailRefresh},
+	}
+	for name, values := range checks {
+		if !values[0].Equal(values[1]) {
+			t.Errorf("Expected %s %s after import, got %s", name, values[1], values[0])
+		}
+	}
+
+	if got.Nameservers != nil {
+		t.Errorf("Expected nameservers to stay unset after import, got %v", got.Nameservers)
+	}
+	if got.Contact != nil {
+		t.Error("Expected shared contact to stay unset after import")
+	}
+	for role, pair := range map[string][2]*ContactModel{
+		"admin":      {got.AdminContact, want.AdminContact},
+		"registrant": {got.RegistrantContact, want.RegistrantContact},
+		"tech":       {got.TechContact, want.TechContact},
+	} {
+		if !contactsEqual(pair[0], pair[1]) {
+			t.Errorf("Expected %s contact %+v after import, got %+v", role, pair[1], pair[0])
+		}
+	}
+}
+
+func TestUpdate_importedDurationYearsDoesNotRenew(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+			RenewDomainFunc: func(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error) {
+				t.Error("RenewDomain should not be called for an imported domain")
+				return &route53domains.RenewDomainOutput{}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	ctx := context.Background()
+	importResp := &resource.ImportStateResponse{State: newResourceState(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "example.com"}, importResp)
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Import returned errors: %v", readResp.Diagnostics)
+	}
+
+	var plan DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &plan)...)
+	plan.DurationYears = tftypes.Int64Value(3)
+
+	resp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, plan), State: readResp.State}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+	}
+
+	var durationYears tftypes.Int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("duration_years"), &durationYears)...)
+	if durationYears.ValueInt64() != 3 {
+		t.Errorf("Expected duration_years 3 to be recorded, got %s", durationYears)
+	}
+}
+
+func TestRead_domainNotFoundRemovesResource(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return nil, &types.InvalidInput{Message: aws.String("Domain example.com not found in account 123456789012")}
+			},
+		},
+	}
+
+	state := newResourceState(t, r, testDomainModel("example.com"))
+	req := resource.ReadRequest{State: state}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
+
+func TestRead_throttlingRetainsState(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+			},
+		},
+	}
+
+	state := newResourceState(t, r, testDomainModel("example.com"))
+	req := resource.ReadRequest{State: state}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error diagnostic")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Fatal("Expected resource to be retained in state")
+	}
+	var domainName tftypes.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("domain_name"), &domainName)...)
+	if domainName.ValueString() != "example.com" {
+		t.Errorf("Expected domain_name to be retained, got %s", domainName.ValueString())
+	}
+}
+
+func TestRead_reachabilityStatus(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+			GetContactReachabilityStatusFunc: func(ctx context.Context, params *route53domains.GetContactReachabilityStatusInput, optFns ...func(*route53domains.Options)) (*route53domains.GetContactReachabilityStatusOutput, error) {
+				return &route53domains.GetContactReachabilityStatusOutput{
+					DomainName: params.DomainName,
+					Status:     types.ReachabilityStatusPending,
+				}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	state := newResourceState(t, r, testDomainModel("example.com"))
+	req := resource.ReadRequest{State: state}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	var status tftypes.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("reachability_status"), &status)...)
+	if status.ValueString() != "PENDING" {
+		t.Errorf("Expected reachability_status PENDING, got %s", status)
+	}
+}
+
+func TestDaysUntilExpiry(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		expiry *time.Time
+		want   tftypes.Int64
+	}{
+		{"thirty days", aws.Time(now.AddDate(0, 0, 30)), tftypes.Int64Value(30)},
+		{"partial day rounds down", aws.Time(now.Add(47 * time.Hour)), tftypes.Int64Value(1)},
+		{"expires today", aws.Time(now.Add(time.Hour)), tftypes.Int64Value(0)},
+		{"expired", aws.Time(now.Add(-36 * time.Hour)), tftypes.Int64Value(-2)},
+		{"no expiry", nil, tftypes.Int64Null()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := daysUntilExpiry(tt.expiry, now); !got.Equal(tt.want) {
+				t.Errorf("daysUntilExpiry() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRead_privacyFlagsPerRole(t *testing.T) {
+	// Only tech privacy was turned off, e.g. in the console
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.TechPrivacy = aws.Bool(false)
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	state := newResourceState(t, r, prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var got DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	want := map[string][2]tftypes.Bool{
+		"admin_privacy":      {got.AdminPrivacy, tftypes.BoolValue(true)},
+		"registrant_privacy": {got.RegistrantPrivacy, tftypes.BoolValue(true)},
+		"tech_privacy":       {got.TechPrivacy, tftypes.BoolValue(false)},
+	}
+	for attr, v := range want {
+		if !v[0].Equal(v[1]) {
+			t.Errorf("%s: got %s, want %s", attr, v[0], v[1])
+		}
+	}
+}
+
+func TestRead_whoisPrivacyEffective(t *testing.T) {
+	// Privacy was requested for every role, but the registry ignored it for
+	// the registrant, whose contact came back as configured
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.AdminContact = &types.ContactDetail{
+					FirstName: aws.String("Redacted"),
+					LastName:  aws.String("For Privacy"),
+					Email:     aws.String("owner-123@privacy.example"),
+				}
+				detail.RegistrantContact = contactModelToAWS(testContact("Registrant@example.com"))
+				detail.TechContact = contactModelToAWS(testContact("tech@example.com"))
+				detail.TechPrivacy = aws.Bool(false)
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	state := newResourceState(t, r, prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var got DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	want := tftypes.MapValueMust(tftypes.BoolType, map[string]attr.Value{
+		"admin":      tftypes.BoolValue(true),
+		"registrant": tftypes.BoolValue(false),
+		"tech":       tftypes.BoolValue(false),
+	})
+	if !got.WhoisPrivacyEffective.Equal(want) {
+		t.Errorf("Expected whois_privacy_effective %s, got %s", want, got.WhoisPrivacyEffective)
+	}
+	if !got.RegistrantPrivacy.ValueBool() {
+		t.Error("Expected registrant_privacy to still report the requested setting")
+	}
+}
+
+func TestRead_durationYearsNotRefreshed(t *testing.T) {
+	// Registered for a year, then renewed outside Terraform for four more
+	detail := MockDomainDetailResponse("example.com")
+	detail.CreationDate = aws.Time(time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC))
+	detail.ExpirationDate = aws.Time(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC))
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	for _, years := range []tftypes.Int64{tftypes.Int64Value(1), tftypes.Int64Null()} {
+		model := testDomainModel("example.com")
+		model.DurationYears = years
+		state := newResourceState(t, r, model)
+		resp := &resource.ReadResponse{State: state}
+		r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+		}
+
+		var refreshed DomainRegistrationResourceModel
+		resp.Diagnostics.Append(resp.State.Get(context.Background(), &refreshed)...)
+		if !refreshed.DurationYears.Equal(years) {
+			t.Errorf("Expected duration_years to stay %v, got %v", years, refreshed.DurationYears)
+		}
+		if got := refreshed.ExpirationDate.ValueString(); got != "2025-03-01T12:00:00Z" {
+			t.Errorf("Expected the renewal in expiration_date, got %s", got)
+		}
+	}
+}
+
+func TestRead_registrarInfo(t *testing.T) {
+	detail := MockDomainDetailResponse("example.com")
+	detail.Reseller = aws.String("Amazon")
+	detail.WhoIsServer = aws.String("whois.registrar.amazon.com")
+	detail.RegistrarName = aws.String("Amazon Registrar, Inc.")
+	detail.RegistrarUrl = aws.String("https://registrar.amazon.com")
+	detail.AbuseContactEmail = aws.String("abuse@amazonaws.com")
+	detail.AbuseContactPhone = aws.String("+1.2024423253")
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	state := newResourceState(t, r, testDomainModel("example.com"))
+	req := resource.ReadRequest{State: state}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	expected := map[string]string{
+		"reseller":            "Amazon",
+		"whois_server":        "whois.registrar.amazon.com",
+		"registrar_name":      "Amazon Registrar, Inc.",
+		"registrar":           "AMAZON",
+		"registrar_url":       "https://registrar.amazon.com",
+		"abuse_contact_email": "abuse@amazonaws.com",
+		"abuse_contact_phone": "+1.2024423253",
+	}
+	for name, want := range expected {
+		var got tftypes.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root(name), &got)...)
+		if got.ValueString() != want {
+			t.Errorf("Expected %s %q, got %s", name, want, got)
+		}
+	}
+}
+
+func TestRegistrarOf(t *testing.T) {
+	tests := []struct {
+		name *string
+		want tftypes.String
+	}{
+		{aws.String("Amazon Registrar, Inc."), stringValue(registrarAmazon)},
+		{aws.String("GANDI SAS"), stringValue(registrarGandi)},
+		{aws.String("Gandi SAS"), stringValue(registrarGandi)},
+		{aws.String("Example Registrar"), stringValue(registrarOther)},
+		{nil, tftypes.StringNull()},
+	}
+	for _, tt := range tests {
+		if got := registrarOf(tt.name); !got.Equal(tt.want) {
+			t.Errorf("registrarOf(%v) = %s, want %s", aws.ToString(tt.name), got, tt.want)
+		}
+	}
+}
+
+func TestModifyPlan_gandiDeletionWarning(t *testing.T) {
+	detail := MockDomainDetailResponse("example.ch")
+	detail.RegistrarName = aws.String("GANDI SAS")
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	model := testDomainModel("example.ch")
+	model.AllowDelete = tftypes.BoolValue(true)
+	state := newResourceState(t, r, model)
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+	var registrar tftypes.String
+	readResp.Diagnostics.Append(readResp.State.GetAttribute(context.Background(), path.Root("registrar"), &registrar)...)
+	if registrar.ValueString() != registrarGandi {
+		t.Fatalf("Expected registrar GANDI, got %s", registrar)
+	}
+
+	destroy := func(state tfsdk.State) diag.Diagnostics {
+		plan := newResourcePlan(t, r, nil)
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+		return resp.Diagnostics
+	}
+
+	diags := destroy(readResp.State)
+	if diags.HasError() || diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Registrar may not support deletion" {
+		t.Fatalf("Expected a registrar deletion warning, got %v", diags)
+	}
+	if !strings.Contains(diags.Warnings()[0].Detail(), "example.ch") {
+		t.Errorf("Expected the warning to name the domain, got %q", diags.Warnings()[0].Detail())
+	}
+
+	// Amazon Registrar domains, and domains only removed from state, are not warned about
+	model.Registrar = stringValue(registrarAmazon)
+	if diags := destroy(newResourceState(t, r, model)); diags.WarningsCount() != 0 {
+		t.Errorf("Expected no warning for Amazon Registrar, got %v", diags)
+	}
+	model.Registrar = stringValue(registrarGandi)
+	model.AllowDelete = tftypes.BoolValue(false)
+	if diags := destroy(newResourceState(t, r, model)); diags.WarningsCount() != 0 {
+		t.Errorf("Expected no warning without allow_delete, got %v", diags)
+	}
+}
+
+func TestDomainDates_consistentAcrossOperations(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	// The same instants as decoded in a non-UTC location, with sub-second
+	// precision RFC3339 drops
+	local := time.FixedZone("UTC-5", -5*60*60)
+	created := time.Date(2026, 3, 1, 7, 30, 0, 123456789, local)
+	expires := time.Date(2027, 3, 1, 7, 30, 0, 987654321, local)
+	detail := MockDomainDetailResponse("example.com")
+	detail.CreationDate = aws.Time(created)
+	detail.ExpirationDate = aws.Time(expires)
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	plan := testDomainModel("example.com")
+	plan.ManageHostedZone = tftypes.BoolValue(false)
+	createResp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+
+	var refreshed DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &refreshed)...)
+	planned := refreshed
+	planned.AutoRenew = tftypes.BoolValue(true)
+	r.client.(*MockRoute53DomainsClient).EnableDomainAutoRenewFunc = func(ctx context.Context, params *route53domains.EnableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.EnableDomainAutoRenewOutput, error) {
+		return &route53domains.EnableDomainAutoRenewOutput{}, nil
+	}
+	updateResp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &planned), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", updateResp.Diagnostics)
+	}
+
+	want := map[string]string{
+		"creation_date":   "2026-03-01T12:30:00Z",
+		"expiration_date": "2027-03-01T12:30:00Z",
+	}
+	for op, state := range map[string]tfsdk.State{"create": createResp.State, "read": readResp.State, "update": updateResp.State} {
+		for name, value := range want {
+			var got tftypes.String
+			state.GetAttribute(ctx, path.Root(name), &got)
+			if got.ValueString() != value {
+				t.Errorf("Expected %s after %s to be %q, got %s", name, op, value, got)
+			}
+		}
+	}
+}
+
+func TestRead_registrarNameservers(t *testing.T) {
+	tests := []struct {
+		name   string
+		manage bool
+		want   []string
+	}{
+		{"managed zone", true, []string{"ns-1.awsdns-01.org", "ns-2.awsdns-02.com"}},
+		{"unmanaged zone", false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recordsInput *route53.ListResourceRecordSetsInput
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						return MockDomainDetailResponse("example.com"), nil
+					},
+				},
+				route53Client: &MockRoute53Client{
+					ListHostedZonesByNameFunc: func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+						return &route53.ListHostedZonesByNameOutput{
+							HostedZones: []route53types.HostedZone{{Id: aws.String("/hostedzone/Z123"), Name: aws.String("example.com.")}},
+						}, nil
+					},
+					ListResourceRecordSetsFunc: func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+						recordsInput = params
+						return &route53.ListResourceRecordSetsOutput{
+							ResourceRecordSets: []route53types.ResourceRecordSet{{
+								Name: aws.String("example.com."),
+								Type: route53types.RRTypeNs,
+								ResourceRecords: []route53types.ResourceRecord{
+									{Value: aws.String("ns-1.awsdns-01.org.")},
+									{Value: aws.String("ns-2.awsdns-02.com.")},
+								},
+							}},
+						}, nil
+					},
+				},
+			}
+
+			model := testDomainModel("example.com")
+			model.ManageHostedZone = tftypes.BoolValue(tt.manage)
+			state := newResourceState(t, r, model)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got DomainRegistrationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+			if tt.want == nil {
+				if !got.RegistrarNameservers.IsNull() {
+					t.Errorf("Expected null registrar_nameservers, got %s", got.RegistrarNameservers)
+				}
+				if recordsInput != nil {
+					t.Error("Expected no ListResourceRecordSets call for an unmanaged zone")
+				}
+				return
+			}
+
+			var nameservers []string
+			resp.Diagnostics.Append(got.RegistrarNameservers.ElementsAs(context.Background(), &nameservers, false)...)
+			if !slices.Equal(nameservers, tt.want) {
+				t.Errorf("Expected registrar_nameservers %v, got %v", tt.want, nameservers)
+			}
+			if aws.ToString(recordsInput.HostedZoneId) != "Z123" || recordsInput.StartRecordType != route53types.RRTypeNs {
+				t.Errorf("Expected the apex NS record of Z123 to be listed, got %+v", recordsInput)
+			}
+		})
+	}
+}
+
+func TestUpdate_resendReachabilityEmail(t *testing.T) {
+	tests := []struct {
+		name       string
+		prior      bool
+		planned    bool
+		wantResend bool
+	}{
+		{"toggled on", false, true, true},
+		{"already on", true, true, false},
+		{"off", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resends := 0
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						return MockDomainDetailResponse(*params.DomainName), nil
+					},
+					ResendContactReachabilityEmailFunc: func(ctx context.Context, params *route53domains.ResendContactReachabilityEmailInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendContactReachabilityEmailOutput, error) {
+						resends++
+						return &route53domains.ResendContactReachabilityEmailOutput{
+							DomainName:   params.DomainName,
+							EmailAddress: aws.String("registrant@example.com"),
+						}, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			prior.ResendReachabilityEmail = tftypes.BoolValue(tt.prior)
+			planned := testDomainModel("example.com")
+			planned.ID = stringValue("example.com")
+			planned.ResendReachabilityEmail = tftypes.BoolValue(tt.planned)
+
+			req := resource.UpdateRequest{
+				Plan:  newResourcePlan(t, r, planned),
+				State: newResourceState(t, r, prior),
+			}
+			resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+			r.Update(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resends > 0; got != tt.wantResend {
+				t.Errorf("Expected resend=%v, got %d calls", tt.wantResend, resends)
+			}
+		})
+	}
+}
+
+func TestUpdate_onlyChangedFields(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	nameservers := []NameserverModel{
+		{Name: stringValue("ns1.example.net")},
+		{Name: stringValue("ns2.example.net")},
+	}
+
+	tests := []struct {
+		name            string
+		modify          func(m *DomainRegistrationResourceModel)
+		wantContact     bool
+		wantPrivacy     bool
+		wantNameservers bool
+	}{
+		{
+			name:   "unchanged",
+			modify: func(m *DomainRegistrationResourceModel) {},
+		},
+		{
+			name: "registrant email changed",
+			modify: func(m *DomainRegistrationResourceModel) {
+				m.RegistrantContact = testContact("new@example.com")
+			},
+			wantContact: true,
+		},
+		{
+			name: "tech privacy changed",
+			modify: func(m *DomainRegistrationResourceModel) {
+				m.TechPrivacy = tftypes.BoolValue(false)
+			},
+			wantPrivacy: true,
+		},
+		{
+			name: "nameserver glue IP added",
+			modify: func(m *DomainRegistrationResourceModel) {
+				m.Nameservers = []NameserverModel{
+					{Name: stringValue("ns1.example.net"), GlueIPs: []tftypes.String{stringValue("192.0.2.1")}},
+					{Name: stringValue("ns2.example.net")},
+				}
+			},
+			wantNameservers: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var contactInput *route53domains.UpdateDomainContactInput
+			var privacyInput *route53domains.UpdateDomainContactPrivacyInput
+			nameserverCalls := 0
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						return MockDomainDetailResponse(*params.DomainName), nil
+					},
+					UpdateDomainContactFunc: func(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error) {
+						contactInput = params
+						return &route53domains.UpdateDomainContactOutput{}, nil
+					},
+					UpdateDomainContactPrivacyFunc: func(ctx context.Context, params *route53domains.UpdateDomainContactPrivacyInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactPrivacyOutput, error) {
+						privacyInput = params
+						return &route53domains.UpdateDomainContactPrivacyOutput{}, nil
+					},
+					UpdateDomainNameserversFunc: func(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error) {
+						nameserverCalls++
+						return &route53domains.UpdateDomainNameserversOutput{OperationId: aws.String("op-ns")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: types.OperationStatusSuccessful}, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			prior.Nameservers = nameservers
+			planned := testDomainModel("example.com")
+			planned.ID = stringValue("example.com")
+			planned.Nameservers = nameservers
+			tt.modify(planned)
+
+			req := resource.UpdateRequest{
+				Plan:  newResourcePlan(t, r, planned),
+				State: newResourceState(t, r, prior),
+			}
+			resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+			r.Update(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+			if (contactInput != nil) != tt.wantContact {
+				t.Errorf("Expected UpdateDomainContact called=%v", tt.wantContact)
+			}
+			if contactInput != nil && (contactInput.AdminContact != nil || contactInput.TechContact != nil || contactInput.RegistrantContact == nil) {
+				t.Errorf("Expected only the registrant contact to be sent, got %+v", contactInput)
+			}
+			if (privacyInput != nil) != tt.wantPrivacy {
+				t.Errorf("Expected UpdateDomainContactPrivacy called=%v", tt.wantPrivacy)
+			}
+			if privacyInput != nil && (privacyInput.AdminPrivacy != nil || privacyInput.RegistrantPrivacy != nil || privacyInput.TechPrivacy == nil || *privacyInput.TechPrivacy) {
+				t.Errorf("Expected only tech privacy=false to be sent, got %+v", privacyInput)
+			}
+			if (nameserverCalls > 0) != tt.wantNameservers {
+				t.Errorf("Expected UpdateDomainNameservers called=%v, got %d calls", tt.wantNameservers, nameserverCalls)
+			}
+		})
+	}
+}
+
+func TestUpdate_autoRenewDriftConvergesInOneApply(t *testing.T) {
+	ctx := context.Background()
+
+	// A transferred-in domain can have auto-renew turned on by AWS
+	remoteAutoRenew := true
+	disableCalls := 0
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.AutoRenew = aws.Bool(remoteAutoRenew)
+				return detail, nil
+			},
+			DisableDomainAutoRenewFunc: func(ctx context.Context, params *route53domains.DisableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainAutoRenewOutput, error) {
+				disableCalls++
+				remoteAutoRenew = false
+				return &route53domains.DisableDomainAutoRenewOutput{}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	refresh := func(state tfsdk.State) tfsdk.State {
+		t.Helper()
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+		}
+		return resp.State
+	}
+	autoRenew := func(state tfsdk.State) tftypes.Bool {
+		t.Helper()
+		var v tftypes.Bool
+		if diags := state.GetAttribute(ctx, path.Root("auto_renew"), &v); diags.HasError() {
+			t.Fatalf("Could not read auto_renew: %v", diags)
+		}
+		return v
+	}
+
+	refreshed := refresh(newResourceState(t, r, prior))
+	if !autoRenew(refreshed).ValueBool() {
+		t.Fatal("Expected Read to report the remote auto_renew = true")
+	}
+
+	planned := testDomainModel("example.com")
+	planned.ID = stringValue("example.com")
+	resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, planned), State: refreshed}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+	}
+	if disableCalls != 1 {
+		t.Errorf("Expected one DisableDomainAutoRenew call, got %d", disableCalls)
+	}
+	if autoRenew(resp.State).ValueBool() {
+		t.Error("Expected auto_renew = false after apply")
+	}
+
+	// The next refresh matches the configuration, so no second apply is needed
+	if autoRenew(refresh(resp.State)).ValueBool() {
+		t.Error("Expected auto_renew to stay false on the next refresh")
+	}
+}
+
+func TestUpdate_contactsOmitUnchangedAndUnset(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var contactInput *route53domains.UpdateDomainContactInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+			UpdateDomainContactFunc: func(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error) {
+				contactInput = params
+				return &route53domains.UpdateDomainContactOutput{}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	// The billing contact was set outside Terraform and is not configured
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.BillingContact = testContact("billing@example.com")
+	planned := testDomainModel("example.com")
+	planned.ID = stringValue("example.com")
+	planned.TechContact = testContact("new-tech@example.com")
+
+	resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  newResourcePlan(t, r, planned),
+		State: newResourceState(t, r, prior),
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if contactInput == nil {
+		t.Fatal("Expected UpdateDomainContact to be called")
+	}
+	if contactInput.TechContact == nil || aws.ToString(contactInput.TechContact.Email) != "new-tech@example.com" {
+		t.Errorf("Expected the changed tech contact to be sent, got %+v", contactInput.TechContact)
+	}
+	if contactInput.AdminContact != nil || contactInput.RegistrantContact != nil {
+		t.Errorf("Expected the unchanged admin and registrant contacts to be omitted, got %+v", contactInput)
+	}
+	if contactInput.BillingContact != nil {
+		t.Errorf("Expected the unconfigured billing contact to be omitted, got %+v", contactInput.BillingContact)
+	}
+}
+
+func TestUpdate_contactUpdateOperationFails(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var polled string
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			UpdateDomainContactFunc: func(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error) {
+				return &route53domains.UpdateDomainContactOutput{OperationId: aws.String("op-contact")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				polled = aws.ToString(params.OperationId)
+				return &route53domains.GetOperationDetailOutput{
+					OperationId: params.OperationId,
+					Status:      types.OperationStatusFailed,
+					Message:     aws.String("Registrant change was not confirmed"),
+				}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	planned := testDomainModel("example.com")
+	planned.ID = stringValue("example.com")
+	planned.AdminContact = testContact("new-admin@example.com")
+
+	resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  newResourcePlan(t, r, planned),
+		State: newResourceState(t, r, prior),
+	}, resp)
+
+	if polled != "op-contact" {
+		t.Errorf("Expected the contact update operation to be polled, got %q", polled)
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a failed contact update")
+	}
+	diagnostic := resp.Diagnostics.Errors()[0]
+	if diagnostic.Summary() != "Contact update failed" {
+		t.Errorf("Unexpected diagnostic summary: %s", diagnostic.Summary())
+	}
+	if !strings.Contains(diagnostic.Detail(), "Registrant change was not confirmed") {
+		t.Errorf("Expected the operation message in the detail, got %q", diagnostic.Detail())
+	}
+}
+
+func TestUpdate_nameserversWaitsForOperation(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	tests := []struct {
+		name      string
+		final     types.OperationStatus
+		wantError bool
+	}{
+		{name: "successful", final: types.OperationStatusSuccessful},
+		{name: "failed", final: types.OperationStatusFailed, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						return MockDomainDetailResponse(*params.DomainName), nil
+					},
+					UpdateDomainNameserversFunc: func(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error) {
+						return &route53domains.UpdateDomainNameserversOutput{OperationId: aws.String("op-ns")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						polls++
+						if aws.ToString(params.OperationId) != "op-ns" {
+							t.Errorf("Expected operation op-ns, got %s", aws.ToString(params.OperationId))
+						}
+						status := types.OperationStatusInProgress
+						if polls > 1 {
+							status = tt.final
+						}
+						return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: status}, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			planned := testDomainModel("example.com")
+			planned.ID = stringValue("example.com")
+			planned.Nameservers = []NameserverModel{
+				{Name: stringValue("ns1.example.net")},
+				{Name: stringValue("ns2.example.net")},
+			}
+
+			req := resource.UpdateRequest{
+				Plan:  newResourcePlan(t, r, planned),
+				State: newResourceState(t, r, prior),
+			}
+			resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+			r.Update(context.Background(), req, resp)
+
+			if polls < 2 {
+				t.Errorf("Expected the nameserver operation to be polled until it finished, got %d polls", polls)
+			}
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("Expected error=%v, got %v", tt.wantError, resp.Diagnostics)
+			}
+			if tt.wantError && resp.Diagnostics.Errors()[0].Summary() != "Nameserver update failed" {
+				t.Errorf("Unexpected diagnostic: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestUpdate_dnssecKeyRotationAddsBeforeRemoving(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	oldKey := DnssecKeyModel{Algorithm: tftypes.Int64Value(8), Flags: tftypes.Int64Value(257), PublicKey: stringValue("AwEAAbOld")}
+	newKey := DnssecKeyModel{Algorithm: tftypes.Int64Value(13), Flags: tftypes.Int64Value(257), PublicKey: stringValue("mdsswUyr3DPW")}
+	zsk := DnssecKeyModel{Algorithm: tftypes.Int64Value(13), Flags: tftypes.Int64Value(256), PublicKey: stringValue("oJMRESz5E4gY")}
+
+	tests := []struct {
+		name         string
+		associateErr error
+		wantCalls    []string
+		wantError    bool
+	}{
+		{
+			name:      "rotation",
+			wantCalls: []string{"associate 13/257", "wait op-associate", "associate 13/256", "wait op-associate", "disassociate key-old", "wait op-disassociate"},
+		},
+		{
+			name:         "failed association keeps the old key",
+			associateErr: errors.New("invalid key"),
+			wantCalls:    []string{"associate 13/257"},
+			wantError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						detail := MockDomainDetailResponse(*params.DomainName)
+						detail.DnssecKeys = []types.DnssecKey{
+							{Id: aws.String("key-old"), Algorithm: aws.Int32(8), Flags: aws.Int32(257), PublicKey: aws.String("AwEAAbOld")},
+							{Id: aws.String("key-new"), Algorithm: aws.Int32(13), Flags: aws.Int32(257), PublicKey: aws.String("mdsswUyr3DPW")},
+							{Id: aws.String("key-zsk"), Algorithm: aws.Int32(13), Flags: aws.Int32(256), PublicKey: aws.String("oJMRESz5E4gY")},
+						}
+						return detail, nil
+					},
+					AssociateDelegationSignerToDomainFunc: func(ctx context.Context, params *route53domains.AssociateDelegationSignerToDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.AssociateDelegationSignerToDomainOutput, error) {
+						calls = append(calls, fmt.Sprintf("associate %d/%d", aws.ToInt32(params.SigningAttributes.Algorithm), aws.ToInt32(params.SigningAttributes.Flags)))
+						if tt.associateErr != nil {
+							return nil, tt.associateErr
+						}
+						return &route53domains.AssociateDelegationSignerToDomainOutput{OperationId: aws.String("op-associate")}, nil
+					},
+					DisassociateDelegationSignerFromDomainFunc: func(ctx context.Context, params *route53domains.DisassociateDelegationSignerFromDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DisassociateDelegationSignerFromDomainOutput, error) {
+						calls = append(calls, "disassociate "+aws.ToString(params.Id))
+						return &route53domains.DisassociateDelegationSignerFromDomainOutput{OperationId: aws.String("op-disassociate")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						calls = append(calls, "wait "+aws.ToString(params.OperationId))
+						return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: types.OperationStatusSuccessful}, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			prior.DnssecKeys = []DnssecKeyModel{oldKey}
+			planned := testDomainModel("example.com")
+			planned.ID = stringValue("example.com")
+			planned.DnssecKeys = []DnssecKeyModel{newKey, zsk}
+
+			resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+			r.Update(context.Background(), resource.UpdateRequest{
+				Plan:  newResourcePlan(t, r, planned),
+				State: newResourceState(t, r, prior),
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("Expected error=%v, got %v", tt.wantError, resp.Diagnostics)
+			}
+			if !slices.Equal(calls, tt.wantCalls) {
+				t.Errorf("Expected calls %v, got %v", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestRead_dnssecKeysDrift(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.DnssecKeys = []types.DnssecKey{
+					{Id: aws.String("key-1"), Algorithm: aws.Int32(13), Flags: aws.Int32(257), PublicKey: aws.String("mdsswUyr3DPW")},
+				}
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	tests := []struct {
+		name       string
+		configured []DnssecKeyModel
+		want       []DnssecKeyModel
+	}{
+		{name: "untracked", configured: nil, want: nil},
+		{
+			name:       "unchanged key keeps its spelling",
+			configured: []DnssecKeyModel{{Algorithm: tftypes.Int64Value(13), Flags: tftypes.Int64Value(257), PublicKey: stringValue("mdssw Uyr3DPW")}},
+			want:       []DnssecKeyModel{{Algorithm: tftypes.Int64Value(13), Flags: tftypes.Int64Value(257), PublicKey: stringValue("mdssw Uyr3DPW")}},
+		},
+		{
+			name:       "drift",
+			configured: []DnssecKeyModel{{Algorithm: tftypes.Int64Value(8), Flags: tftypes.Int64Value(257), PublicKey: stringValue("AwEAAbOld")}},
+			want:       []DnssecKeyModel{{Algorithm: tftypes.Int64Value(13), Flags: tftypes.Int64Value(257), PublicKey: stringValue("mdsswUyr3DPW")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := testDomainModel("example.com")
+			model.ID = stringValue("example.com")
+			model.DnssecKeys = tt.configured
+			state := newResourceState(t, r, model)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got DomainRegistrationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+			if !reflect.DeepEqual(got.DnssecKeys, tt.want) {
+				t.Errorf("Expected dnssec_keys %v, got %v", tt.want, got.DnssecKeys)
+			}
+		})
+	}
+}
+
+func TestUpdate_nameserversDeduplicatedAndSorted(t *testing.T) {
+	var sent []string
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.Nameservers = []types.Nameserver{{Name: aws.String("ns1.example.net")}, {Name: aws.String("ns2.example.net")}}
+				return detail, nil
+			},
+			UpdateDomainNameserversFunc: func(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error) {
+				for _, ns := range params.Nameservers {
+					sent = append(sent, aws.ToString(ns.Name))
+				}
+				return &route53domains.UpdateDomainNameserversOutput{}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	planned := testDomainModel("example.com")
+	planned.ID = stringValue("example.com")
+	planned.Nameservers = []NameserverModel{
+		{Name: stringValue("ns2.example.net")},
+		{Name: stringValue("NS1.example.net")},
+		{Name: stringValue("ns2.example.net")},
+		{Name: stringValue("ns1.example.net.")},
+	}
+
+	req := resource.UpdateRequest{
+		Plan:  newResourcePlan(t, r, planned),
+		State: newResourceState(t, r, prior),
+	}
+	resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+	}
+
+	if len(sent) != 2 || sent[0] != "NS1.example.net" || sent[1] != "ns2.example.net" {
+		t.Errorf("Expected the deduplicated, sorted nameservers to be sent, got %v", sent)
+	}
+
+	// The refresh reports the same set, so the configured list is kept as is
+	var got DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if len(got.Nameservers) != 4 || got.Nameservers[0].Name.ValueString() != "ns2.example.net" {
+		t.Errorf("Expected the configured nameservers to be kept, got %v", got.Nameservers)
+	}
+}
+
+func TestUpdate_removingNameserversRevertsToHostedZone(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	tests := []struct {
+		name        string
+		manage      bool
+		zoneRecords []route53types.ResourceRecord
+		want        []string
+		wantWarning bool
+	}{
+		{
+			name:        "registrar zone",
+			manage:      true,
+			zoneRecords: []route53types.ResourceRecord{{Value: aws.String("ns-1.awsdns-01.org.")}, {Value: aws.String("ns-2.awsdns-02.com.")}},
+			want:        []string{"ns-1.awsdns-01.org", "ns-2.awsdns-02.com"},
+		},
+		{name: "zone without nameservers", manage: true, wantWarning: true},
+		{name: "unmanaged zone", manage: false, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nameserverInput *route53domains.UpdateDomainNameserversInput
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						return MockDomainDetailResponse(*params.DomainName), nil
+					},
+					UpdateDomainNameserversFunc: func(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error) {
+						nameserverInput = params
+						return &route53domains.UpdateDomainNameserversOutput{OperationId: aws.String("op-ns")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: types.OperationStatusSuccessful}, nil
+					},
+				},
+				route53Client: &MockRoute53Client{
+					ListResourceRecordSetsFunc: func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+						if aws.ToString(params.HostedZoneId) != "Z123" {
+							t.Errorf("Expected the zone from state, got %s", aws.ToString(params.HostedZoneId))
+						}
+						var sets []route53types.ResourceRecordSet
+						if tt.zoneRecords != nil {
+							sets = append(sets, route53types.ResourceRecordSet{Name: aws.String("example.com."), Type: route53types.RRTypeNs, ResourceRecords: tt.zoneRecords})
+						}
+						return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: sets}, nil
+					},
+				},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			prior.ManageHostedZone = tftypes.BoolValue(tt.manage)
+			prior.HostedZoneID = stringValue("Z123")
+			prior.Nameservers = []NameserverModel{{Name: stringValue("ns1.example.net")}, {Name: stringValue("ns2.example.net")}}
+			planned := testDomainModel("example.com")
+			planned.ID = stringValue("example.com")
+			planned.ManageHostedZone = tftypes.BoolValue(tt.manage)
+			planned.HostedZoneID = stringValue("Z123")
+
+			req := resource.UpdateRequest{
+				Plan:  newResourcePlan(t, r, planned),
+				State: newResourceState(t, r, prior),
+			}
+			resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+			r.Update(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() == 1; got != tt.wantWarning {
+				t.Errorf("Expected warning=%v, got %v", tt.wantWarning, resp.Diagnostics)
+			}
+			if tt.want == nil {
+				if nameserverInput != nil {
+					t.Errorf("Expected the nameservers to be left alone, got %+v", nameserverInput)
+				}
+				return
+			}
+			if nameserverInput == nil {
+				t.Fatal("Expected UpdateDomainNameservers to be called")
+			}
+			var got []string
+			for _, ns := range nameserverInput.Nameservers {
+				got = append(got, aws.ToString(ns.Name))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Expected the zone's nameservers %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRead_nameserverGlueIPs(t *testing.T) {
+	configured := []NameserverModel{
+		{Name: stringValue("ns1.example.com"), GlueIPs: []tftypes.String{stringValue("2001:DB8::53"), stringValue("192.0.2.53")}},
+		{Name: stringValue("ns.example.net")},
+	}
+
+	tests := []struct {
+		name     string
+		reported []types.Nameserver
+		want     []NameserverModel
+	}{
+		{
+			name: "same glue in another order and form",
+			reported: []types.Nameserver{
+				{Name: aws.String("ns.example.net")},
+				{Name: aws.String("ns1.example.com"), GlueIps: []string{"192.0.2.53", "2001:db8::53"}},
+			},
+			want: configured,
+		},
+		{
+			name: "glue changed outside Terraform",
+			reported: []types.Nameserver{
+				{Name: aws.String("ns1.example.com"), GlueIps: []string{"192.0.2.54"}},
+				{Name: aws.String("ns.example.net")},
+			},
+			want: []NameserverModel{
+				{Name: stringValue("ns1.example.com"), GlueIPs: []tftypes.String{stringValue("192.0.2.54")}},
+				{Name: stringValue("ns.example.net")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						detail := MockDomainDetailResponse(*params.DomainName)
+						detail.Nameservers = tt.reported
+						return detail, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			prior.Nameservers = configured
+			state := newResourceState(t, r, prior)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var got DomainRegistrationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+			if !reflect.DeepEqual(got.Nameservers, tt.want) {
+				t.Errorf("Expected nameservers %v, got %v", tt.want, got.Nameservers)
+			}
+		})
+	}
+}
+
+func TestNameserversEqual(t *testing.T) {
+	ns := func(names ...string) []NameserverModel {
+		var m []NameserverModel
+		for _, n := range names {
+			m = append(m, NameserverModel{Name: stringValue(n)})
+		}
+		return m
+	}
+
+	tests := []struct {
+		name string
+		a, b []NameserverModel
+		want bool
+	}{
+		{"same", ns("ns1.example.net", "ns2.example.net"), ns("ns1.example.net", "ns2.example.net"), true},
+		{"reordered", ns("ns2.example.net", "ns1.example.net"), ns("ns1.example.net", "ns2.example.net"), true},
+		{"case and duplicates", ns("NS1.example.net", "ns1.example.net", "ns2.example.net"), ns("ns1.example.net", "ns2.example.net"), true},
+		{"different", ns("ns1.example.net", "ns3.example.net"), ns("ns1.example.net", "ns2.example.net"), false},
+		{"subset", ns("ns1.example.net"), ns("ns1.example.net", "ns2.example.net"), false},
+		{
+			"glue changed",
+			[]NameserverModel{{Name: stringValue("ns1.example.com"), GlueIPs: []tftypes.String{stringValue("192.0.2.1")}}},
+			[]NameserverModel{{Name: stringValue("ns1.example.com"), GlueIPs: []tftypes.String{stringValue("192.0.2.2")}}},
+			false,
+		},
+		{
+			"glue as a set",
+			[]NameserverModel{{Name: stringValue("ns1.example.com"), GlueIPs: []tftypes.String{stringValue("2001:DB8:0::1"), stringValue("192.0.2.1"), stringValue("192.0.2.1")}}},
+			[]NameserverModel{{Name: stringValue("ns1.example.com"), GlueIPs: []tftypes.String{stringValue("192.0.2.1"), stringValue("2001:db8::1")}}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nameserversEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDelete_timeout(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Millisecond)()
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			DeleteDomainFunc: func(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error) {
+				return &route53domains.DeleteDomainOutput{OperationId: aws.String("op-delete")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusInProgress}, nil
+			},
+		},
+		route53Client: failingRoute53Client(t),
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.AllowDelete = tftypes.BoolValue(true)
+	prior.DeleteTimeout = tftypes.Int64Value(1)
+	state := newResourceState(t, r, prior)
+
+	start := time.Now()
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Delete did not return after delete_timeout (took %s)", elapsed)
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if len(resp.Diagnostics.Warnings()) != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Domain deletion still in progress" {
+		t.Errorf("Expected a deletion in progress warning, got %v", resp.Diagnostics)
+	}
+}
+
+func TestDelete_timeoutWhileThrottled(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Millisecond)()
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			DeleteDomainFunc: func(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error) {
+				return &route53domains.DeleteDomainOutput{OperationId: aws.String("op-delete")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+			},
+		},
+		route53Client: failingRoute53Client(t),
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.AllowDelete = tftypes.BoolValue(true)
+	prior.DeleteTimeout = tftypes.Int64Value(1)
+	state := newResourceState(t, r, prior)
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || warnings[0].Summary() != "Domain deletion still in progress" || !strings.Contains(warnings[0].Detail(), "is still SUBMITTED") {
+		t.Errorf("Expected a deletion in progress warning, got %v", resp.Diagnostics)
+	}
+}
+
+func TestDelete_unlockBeforeDelete(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Millisecond)()
+
+	locked := true
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			DisableDomainTransferLockFunc: func(ctx context.Context, params *route53domains.DisableDomainTransferLockInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainTransferLockOutput, error) {
+				locked = false
+				return &route53domains.DisableDomainTransferLockOutput{OperationId: aws.String("op-unlock")}, nil
+			},
+			DeleteDomainFunc: func(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error) {
+				if locked {
+					return nil, &types.InvalidInput{Message: aws.String("Domain has clientDeleteProhibited status")}
+				}
+				return &route53domains.DeleteDomainOutput{OperationId: aws.String("op-delete")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: types.OperationStatusSuccessful}, nil
+			},
+		},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.AllowDelete = tftypes.BoolValue(true)
+	prior.ManageHostedZone = tftypes.BoolValue(false)
+	prior.UnlockBeforeDelete = tftypes.BoolValue(true)
+	state := newResourceState(t, r, prior)
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if locked {
+		t.Error("Expected DisableDomainTransferLock to be called before DeleteDomain")
+	}
+}
+
+func TestDelete_unlockFailure(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Millisecond)()
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			DisableDomainTransferLockFunc: func(ctx context.Context, params *route53domains.DisableDomainTransferLockInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainTransferLockOutput, error) {
+				return &route53domains.DisableDomainTransferLockOutput{OperationId: aws.String("op-unlock")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusFailed, Message: aws.String("Registry lock")}, nil
+			},
+			DeleteDomainFunc: func(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error) {
+				t.Error("DeleteDomain should not be called when the lock cannot be removed")
+				return &route53domains.DeleteDomainOutput{}, nil
+			},
+		},
+		route53Client: failingRoute53Client(t),
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.AllowDelete = tftypes.BoolValue(true)
+	prior.UnlockBeforeDelete = tftypes.BoolValue(true)
+	state := newResourceState(t, r, prior)
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Error unlocking domain" {
+		t.Errorf("Expected an unlock error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestUpdate_durationIncreaseRenews(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var renewInput *route53domains.RenewDomainInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RenewDomainFunc: func(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error) {
+				renewInput = params
+				return &route53domains.RenewDomainOutput{OperationId: aws.String("op-renew")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.ExpirationDate = stringValue("2027-05-01T00:00:00Z")
+	planned := testDomainModel("example.com")
+	planned.ID = stringValue("example.com")
+	planned.DurationYears = tftypes.Int64Value(3)
+
+	req := resource.UpdateRequest{
+		Plan:  newResourcePlan(t, r, planned),
+		State: newResourceState(t, r, prior),
+	}
+	resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if renewInput == nil {
+		t.Fatal("Expected RenewDomain to be called")
+	}
+	if aws.ToInt32(renewInput.DurationInYears) != 2 {
+		t.Errorf("Expected renewal for 2 years, got %d", aws.ToInt32(renewInput.DurationInYears))
+	}
+	if renewInput.CurrentExpiryYear != 2027 {
+		t.Errorf("Expected current expiry year 2027, got %d", renewInput.CurrentExpiryYear)
+	}
+}
+
+// failingRoute53Client returns a Route53 mock that fails the test on any call.
+func failingRoute53Client(t *testing.T) *MockRoute53Client {
+	return &MockRoute53Client{
+		DeleteHostedZoneFunc: func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+			t.Error("Unexpected DeleteHostedZone call")
+			return &route53.DeleteHostedZoneOutput{}, nil
+		},
+		ListHostedZonesByNameFunc: func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+			t.Error("Unexpected ListHostedZonesByName call")
+			return &route53.ListHostedZonesByNameOutput{}, nil
+		},
+		ListResourceRecordSetsFunc: func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+			t.Error("Unexpected ListResourceRecordSets call")
+			return &route53.ListResourceRecordSetsOutput{}, nil
+		},
+	}
+}
+
+// pagedHostedZonesClient serves ListHostedZonesByName one zone per page,
+// starting at the requested name like Route53 does.
+func pagedHostedZonesClient(zones ...route53types.HostedZone) (*MockRoute53Client, *int) {
+	calls := 0
+	return &MockRoute53Client{
+		ListHostedZonesByNameFunc: func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+			calls++
+			i := 0
+			if params.HostedZoneId != nil {
+				for i < len(zones) && aws.ToString(zones[i].Id) != aws.ToString(params.HostedZoneId) {
+					i++
+				}
+			}
+			output := &route53.ListHostedZonesByNameOutput{HostedZones: zones[i : i+1]}
+			if i+1 < len(zones) {
+				output.IsTruncated = true
+				output.NextDNSName = zones[i+1].Name
+				output.NextHostedZoneId = zones[i+1].Id
+			}
+			return output, nil
+		},
+		ListResourceRecordSetsFunc: func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+			return &route53.ListResourceRecordSetsOutput{
+				ResourceRecordSets: []route53types.ResourceRecordSet{
+					{Name: aws.String("example.com."), Type: route53types.RRTypeNs},
+					{Name: aws.String("example.com."), Type: route53types.RRTypeSoa},
+				},
+			}, nil
+		},
+	}, &calls
+}
+
+func TestFindHostedZoneID_paginates(t *testing.T) {
+	registrarZone := route53types.HostedZone{
+		Id:     aws.String("/hostedzone/ZTARGET"),
+		Name:   aws.String("example.com."),
+		Config: &route53types.HostedZoneConfig{Comment: aws.String("HostedZone created by Route53 Registrar")},
+	}
+
+	t.Run("lookup", func(t *testing.T) {
+		client, calls := pagedHostedZonesClient(
+			route53types.HostedZone{Id: aws.String("/hostedzone/ZDEV"), Name: aws.String("dev.example.com.")},
+			registrarZone,
+		)
+		r := &DomainRegistrationResource{route53Client: client}
+
+		zoneID, err := r.findHostedZoneID(context.Background(), "example.com")
+		if err != nil {
+			t.Fatalf("findHostedZoneID returned error: %v", err)
+		}
+		if zoneID != "ZTARGET" {
+			t.Errorf("Expected ZTARGET, got %s", zoneID)
+		}
+		if *calls != 2 {
+			t.Errorf("Expected 2 pages to be read, got %d", *calls)
+		}
+	})
+
+	t.Run("not found after last page", func(t *testing.T) {
+		client, calls := pagedHostedZonesClient(
+			route53types.HostedZone{Id: aws.String("/hostedzone/ZDEV"), Name: aws.String("dev.example.com.")},
+			route53types.HostedZone{Id: aws.String("/hostedzone/ZOTHER"), Name: aws.String("example.net.")},
+		)
+		r := &DomainRegistrationResource{route53Client: client}
+
+		if _, err := r.findHostedZoneID(context.Background(), "example.com"); !errors.Is(err, errHostedZoneNotFound) {
+			t.Errorf("Expected errHostedZoneNotFound, got %v", err)
+		}
+		if *calls != 2 {
+			t.Errorf("Expected every page to be read, got %d", *calls)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		client, _ := pagedHostedZonesClient(
+			route53types.HostedZone{Id: aws.String("/hostedzone/ZDEV"), Name: aws.String("dev.example.com.")},
+			registrarZone,
+		)
+		var deleted string
+		client.DeleteHostedZoneFunc = func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+			deleted = aws.ToString(params.Id)
+			return &route53.DeleteHostedZoneOutput{}, nil
+		}
+		r := &DomainRegistrationResource{route53Client: client}
+
+		if err := r.deleteRegistrarHostedZone(context.Background(), "example.com", []string{registrarZoneComment}, nil); err != nil {
+			t.Fatalf("deleteRegistrarHostedZone returned error: %v", err)
+		}
+		if deleted != "/hostedzone/ZTARGET" {
+			t.Errorf("Expected the registrar zone on the second page to be deleted, got %q", deleted)
+		}
+	})
+}
+
+func TestDeleteRegistrarHostedZone_acceptedComments(t *testing.T) {
+	const alternate = "HostedZone created by Route 53 Registrar"
+	tests := []struct {
+		name        string
+		comments    []tftypes.String
+		wantDeleted bool
+	}{
+		{"default rejects alternate comment", nil, false},
+		{"configured alternate comment", []tftypes.String{tftypes.StringValue(registrarZoneComment), tftypes.StringValue(alternate)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := pagedHostedZonesClient(route53types.HostedZone{
+				Id:     aws.String("/hostedzone/Z123"),
+				Name:   aws.String("example.com."),
+				Config: &route53types.HostedZoneConfig{Comment: aws.String(alternate)},
+			})
+			deleted := false
+			client.DeleteHostedZoneFunc = func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+				deleted = true
+				return &route53.DeleteHostedZoneOutput{}, nil
+			}
+			r := &DomainRegistrationResource{route53Client: client}
+
+			data := *testDomainModel("example.com")
+			data.RegistrarZoneComments = tt.comments
+			err := r.deleteRegistrarHostedZone(context.Background(), "example.com", registrarZoneComments(data), nil)
+			if deleted != tt.wantDeleted {
+				t.Errorf("Expected deleted = %v, got %v (err: %v)", tt.wantDeleted, deleted, err)
+			}
+			if !tt.wantDeleted && (err == nil || !strings.Contains(err.Error(), "does not match")) {
+				t.Errorf("Expected a comment mismatch error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteRegistrarHostedZone_paginatesRecords(t *testing.T) {
+	tests := []struct {
+		name        string
+		secondPage  []route53types.ResourceRecordSet
+		wantDeleted bool
+	}{
+		{"custom record on second page", []route53types.ResourceRecordSet{{Name: aws.String("www.example.com."), Type: route53types.RRTypeA}}, false},
+		{"only NS and SOA", []route53types.ResourceRecordSet{{Name: aws.String("example.com."), Type: route53types.RRTypeSoa}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := pagedHostedZonesClient(route53types.HostedZone{
+				Id:     aws.String("/hostedzone/Z123"),
+				Name:   aws.String("example.com."),
+				Config: &route53types.HostedZoneConfig{Comment: aws.String(registrarZoneComment)},
+			})
+			var starts []string
+			client.ListResourceRecordSetsFunc = func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+				starts = append(starts, aws.ToString(params.StartRecordName))
+				if params.StartRecordName == nil {
+					return &route53.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []route53types.ResourceRecordSet{{Name: aws.String("example.com."), Type: route53types.RRTypeNs}},
+						IsTruncated:        true,
+						NextRecordName:     tt.secondPage[0].Name,
+						NextRecordType:     tt.secondPage[0].Type,
+					}, nil
+				}
+				return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: tt.secondPage}, nil
+			}
+			deleted := false
+			client.DeleteHostedZoneFunc = func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+				deleted = true
+				return &route53.DeleteHostedZoneOutput{}, nil
+			}
+			r := &DomainRegistrationResource{route53Client: client}
+
+			err := r.deleteRegistrarHostedZone(context.Background(), "example.com", []string{registrarZoneComment}, nil)
+			if len(starts) != 2 {
+				t.Fatalf("Expected both record pages to be read, got %d", len(starts))
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("Expected deleted = %v, got %v (err: %v)", tt.wantDeleted, deleted, err)
+			}
+			var safety *zoneSafetyError
+			if !tt.wantDeleted && (!errors.As(err, &safety) || safety.Check != zoneCheckRecords) {
+				t.Errorf("Expected a custom records safety error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteRegistrarHostedZone_deletableRecords(t *testing.T) {
+	txt := route53types.ResourceRecordSet{
+		Name:            aws.String("_verify.example.com."),
+		Type:            route53types.RRTypeTxt,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []route53types.ResourceRecord{{Value: aws.String(`"token"`)}},
+	}
+	records := func(extra ...route53types.ResourceRecordSet) []route53types.ResourceRecordSet {
+		return append([]route53types.ResourceRecordSet{
+			{Name: aws.String("example.com."), Type: route53types.RRTypeNs},
+			{Name: aws.String("example.com."), Type: route53types.RRTypeSoa},
+		}, extra...)
+	}
+
+	tests := []struct {
+		name        string
+		records     []route53types.ResourceRecordSet
+		deletable   []ZoneRecordModel
+		wantDeleted bool
+	}{
+		{"TXT by name", records(txt), []ZoneRecordModel{{Type: stringValue("TXT"), Name: stringValue("_Verify.example.com")}}, true},
+		{"TXT at any name", records(txt), []ZoneRecordModel{{Type: stringValue("txt"), Name: tftypes.StringNull()}}, true},
+		{"not listed", records(txt), nil, false},
+		{"other name", records(txt), []ZoneRecordModel{{Type: stringValue("TXT"), Name: stringValue("_other.example.com")}}, false},
+		{"record outside the list", records(txt, route53types.ResourceRecordSet{Name: aws.String("www.example.com."), Type: route53types.RRTypeA}),
+			[]ZoneRecordModel{{Type: stringValue("TXT"), Name: tftypes.StringNull()}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := pagedHostedZonesClient(route53types.HostedZone{
+				Id:     aws.String("/hostedzone/Z123"),
+				Name:   aws.String("example.com."),
+				Config: &route53types.HostedZoneConfig{Comment: aws.String(registrarZoneComment)},
+			})
+			client.ListResourceRecordSetsFunc = func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+				return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: tt.records}, nil
+			}
+			var changes []route53types.Change
+			client.ChangeResourceRecordSetsFunc = func(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+				changes = append(changes, params.ChangeBatch.Changes...)
+				return &route53.ChangeResourceRecordSetsOutput{}, nil
+			}
+			deleted := false
+			client.DeleteHostedZoneFunc = func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+				if len(changes) != 1 {
+					t.Error("Expected the TXT record to be deleted before the zone")
+				}
+				deleted = true
+				return &route53.DeleteHostedZoneOutput{}, nil
+			}
+			r := &DomainRegistrationResource{route53Client: client}
+
+			err := r.deleteRegistrarHostedZone(context.Background(), "example.com", []string{registrarZoneComment}, tt.deletable)
+			if deleted != tt.wantDeleted {
+				t.Fatalf("Expected deleted = %v, got %v (err: %v)", tt.wantDeleted, deleted, err)
+			}
+			if !tt.wantDeleted {
+				var safety *zoneSafetyError
+				if !errors.As(err, &safety) || safety.Check != zoneCheckRecords {
+					t.Errorf("Expected a custom records safety error, got %v", err)
+				}
+				if len(changes) != 0 {
+					t.Errorf("Expected no records to be deleted from a kept zone, got %v", changes)
+				}
+				return
+			}
+			if changes[0].Action != route53types.ChangeActionDelete || aws.ToString(changes[0].ResourceRecordSet.Name) != "_verify.example.com." {
+				t.Errorf("Expected the TXT record to be deleted, got %+v", changes[0])
+			}
+		})
+	}
+}
+
+func TestDelete_hostedZoneSafetyChecks(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	registrarConfig := &route53types.HostedZoneConfig{Comment: aws.String(registrarZoneComment)}
+	tests := []struct {
+		name        string
+		config      *route53types.HostedZoneConfig
+		records     []route53types.ResourceRecordSet
+		wantCheck   zoneSafetyCheck
+		wantSummary string
+	}{
+		{
+			name:        "private zone",
+			config:      &route53types.HostedZoneConfig{Comment: aws.String(registrarZoneComment), PrivateZone: true},
+			wantCheck:   zoneCheckPrivate,
+			wantSummary: "Hosted zone is private",
+		},
+		{
+			name:        "comment mismatch",
+			config:      &route53types.HostedZoneConfig{Comment: aws.String("managed by terraform")},
+			wantCheck:   zoneCheckComment,
+			wantSummary: "Hosted zone comment not recognized",
+		},
+		{
+			name:   "custom records",
+			config: registrarConfig,
+			records: []route53types.ResourceRecordSet{
+				{Name: aws.String("www.example.com."), Type: route53types.RRTypeA},
+			},
+			wantCheck:   zoneCheckRecords,
+			wantSummary: "Hosted zone has custom records",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route53Client, _ := pagedHostedZonesClient(route53types.HostedZone{
+				Id:     aws.String("/hostedzone/Z123"),
+				Name:   aws.String("example.com."),
+				Config: tt.config,
+			})
+			if tt.records != nil {
+				route53Client.ListResourceRecordSetsFunc = func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+					return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: tt.records}, nil
+				}
+			}
+			route53Client.DeleteHostedZoneFunc = func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+				t.Error("Expected the hosted zone to be kept")
+				return &route53.DeleteHostedZoneOutput{}, nil
+			}
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					DeleteDomainFunc: func(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error) {
+						return &route53domains.DeleteDomainOutput{OperationId: aws.String("op-delete")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+					},
+				},
+				route53Client: route53Client,
+			}
+
+			err := r.deleteRegistrarHostedZone(context.Background(), "example.com", []string{registrarZoneComment}, nil)
+			var safety *zoneSafetyError
+			if !errors.As(err, &safety) || safety.Check != tt.wantCheck || safety.ZoneID != "Z123" {
+				t.Fatalf("Expected a %s safety error, got %v", tt.wantCheck, err)
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			prior.AllowDelete = tftypes.BoolValue(true)
+			state := newResourceState(t, r, prior)
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected zone cleanup not to fail the destroy, got %v", resp.Diagnostics)
+			}
+			if len(resp.Diagnostics.Warnings()) != 1 || resp.Diagnostics.Warnings()[0].Summary() != tt.wantSummary {
+				t.Errorf("Expected a %q warning, got %v", tt.wantSummary, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCreate_deleteHostedZoneWaitsForZone(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	previous := hostedZonePollInterval
+	hostedZonePollInterval = time.Millisecond
+	defer func() { hostedZonePollInterval = previous }()
+
+	lookups := 0
+	var deleted string
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client: &MockRoute53Client{
+			ListHostedZonesByNameFunc: func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+				lookups++
+				if lookups == 1 {
+					return &route53.ListHostedZonesByNameOutput{}, nil
+				}
+				return &route53.ListHostedZonesByNameOutput{
+					HostedZones: []route53types.HostedZone{{
+						Id:   aws.String("/hostedzone/Z123"),
+						Name: aws.String("example.com."),
+						Config: &route53types.HostedZoneConfig{
+							Comment: aws.String("HostedZone created by Route53 Registrar"),
+						},
+					}},
+				}, nil
+			},
+			ListResourceRecordSetsFunc: func(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+				return &route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []route53types.ResourceRecordSet{
+						{Name: aws.String("example.com."), Type: route53types.RRTypeNs},
+						{Name: aws.String("example.com."), Type: route53types.RRTypeSoa},
+					},
+				}, nil
+			},
+			DeleteHostedZoneFunc: func(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error) {
+				deleted = aws.ToString(params.Id)
+				return &route53.DeleteHostedZoneOutput{}, nil
+			},
+		},
+	}
+
+	plan := testDomainModel("example.com")
+	plan.DeleteHostedZone = tftypes.BoolValue(true)
+
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if lookups < 2 {
+		t.Errorf("Expected the hosted zone lookup to be retried, got %d lookups", lookups)
+	}
+	if deleted != "/hostedzone/Z123" {
+		t.Errorf("Expected hosted zone /hostedzone/Z123 to be deleted, got %q", deleted)
+	}
+
+	var hostedZoneID tftypes.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("hosted_zone_id"), &hostedZoneID)...)
+	if !hostedZoneID.IsNull() {
+		t.Errorf("Expected hosted_zone_id to be null after deletion, got %s", hostedZoneID)
+	}
+}
+
+func TestManageHostedZoneDisabled_noRoute53Calls(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client: failingRoute53Client(t),
+	}
+
+	plan := testDomainModel("example.com")
+	plan.ManageHostedZone = tftypes.BoolValue(false)
+	plan.DeleteHostedZone = tftypes.BoolValue(true)
+	plan.AllowDelete = tftypes.BoolValue(true)
+
+	createResp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+	var hostedZoneID tftypes.String
+	readResp.Diagnostics.Append(readResp.State.GetAttribute(ctx, path.Root("hosted_zone_id"), &hostedZoneID)...)
+	if !hostedZoneID.IsNull() {
+		t.Errorf("Expected null hosted_zone_id, got %s", hostedZoneID)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete returned errors: %v", deleteResp.Diagnostics)
+	}
+}
+
+func TestRead_contactsRespectPrivacy(t *testing.T) {
+	tests := []struct {
+		name      string
+		privacy   bool
+		noPrior   bool
+		wantEmail string
+	}{
+		{"privacy protected keeps configured contact", true, false, "registrant@example.com"},
+		{"unprotected contact detects drift", false, false, "changed@example.com"},
+		{"privacy protected without prior stays unset", true, true, ""},
+		{"unprotected without prior is read", false, true, "changed@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail := MockDomainDetailResponse("example.com")
+			detail.RegistrantPrivacy = aws.Bool(tt.privacy)
+			redacted := contactModelToAWS(testContact("registrant@example.com"))
+			if tt.privacy {
+				redacted.Email = aws.String("abc123@privacy.example.net")
+				redacted.AddressLine1 = aws.String("REDACTED FOR PRIVACY")
+			} else {
+				redacted.Email = aws.String("changed@example.com")
+			}
+			detail.RegistrantContact = redacted
+
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						return detail, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			prior := testDomainModel("example.com")
+			prior.ID = stringValue("example.com")
+			if tt.noPrior {
+				// As after an import
+				prior.RegistrantContact = nil
+			}
+			state := newResourceState(t, r, prior)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got DomainRegistrationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Could not read state: %v", resp.Diagnostics)
+			}
+			if got.RegistrantContactHash.IsNull() || got.RegistrantContactHash.IsUnknown() {
+				t.Errorf("Expected registrant_contact_hash to be set, got %s", got.RegistrantContactHash)
+			}
+			if tt.wantEmail == "" {
+				if got.RegistrantContact != nil {
+					t.Errorf("Expected redacted contact to stay unset, got %+v", got.RegistrantContact)
+				}
+				return
+			}
+			if got.RegistrantContact == nil {
+				t.Fatal("Expected registrant_contact to be set")
+			}
+			contact := *got.RegistrantContact
+			if contact.Email.ValueString() != tt.wantEmail {
+				t.Errorf("Expected email %s, got %s", tt.wantEmail, contact.Email.ValueString())
+			}
+			if tt.privacy && !contactsEqual(&contact, prior.RegistrantContact) {
+				t.Errorf("Expected privacy-protected contact to be unchanged, got %+v", contact)
+			}
+			if !contact.ContactType.IsNull() {
+				t.Errorf("Expected default contact_type to stay null, got %s", contact.ContactType)
+			}
+		})
+	}
+}
+
+func TestCreate_sharedContact(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var input *route53domains.RegisterDomainInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				input = params
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	plan := testDomainModel("example.com")
+	plan.Contact = testContact("shared@example.com")
+	plan.AdminContact = testContact("admin@example.com")
+	plan.RegistrantContact = nil
+	plan.TechContact = nil
+
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	emails := map[string]string{
+		"admin":      aws.ToString(input.AdminContact.Email),
+		"registrant": aws.ToString(input.RegistrantContact.Email),
+		"tech":       aws.ToString(input.TechContact.Email),
+	}
+	want := map[string]string{
+		"admin":      "admin@example.com",
+		"registrant": "shared@example.com",
+		"tech":       "shared@example.com",
+	}
+	for role, email := range want {
+		if emails[role] != email {
+			t.Errorf("Expected %s contact email %s, got %s", role, email, emails[role])
+		}
+	}
+
+	var state DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.RegistrantContact != nil || state.TechContact != nil {
+		t.Error("Expected roles using the shared contact to stay unset in state")
+	}
+}
+
+func TestCreate_contactTemplates(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var input *route53domains.RegisterDomainInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				input = params
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client:    &MockRoute53Client{},
+		contactTemplates: map[string]ContactModel{"ops": *testContact("ops@example.com")},
+	}
+
+	// tech_contact overrides its template, and the registrant falls back
+	// past the unset registrant template to the shared contact
+	plan := testDomainModel("example.com")
+	plan.Contact = testContact("shared@example.com")
+	plan.AdminContact = nil
+	plan.AdminContactTemplate = stringValue("ops")
+	plan.RegistrantContact = nil
+	plan.TechContactTemplate = stringValue("ops")
+	plan.ContactTemplateHashes = tftypes.MapUnknown(tftypes.StringType)
+
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	emails := map[string]string{
+		"admin":      aws.ToString(input.AdminContact.Email),
+		"registrant": aws.ToString(input.RegistrantContact.Email),
+		"tech":       aws.ToString(input.TechContact.Email),
+	}
+	want := map[string]string{
+		"admin":      "ops@example.com",
+		"registrant": "shared@example.com",
+		"tech":       "tech@example.com",
+	}
+	for role, email := range want {
+		if emails[role] != email {
+			t.Errorf("Expected %s contact email %s, got %s", role, email, emails[role])
+		}
+	}
+
+	var state DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+	if state.AdminContact != nil {
+		t.Error("Expected the role using a template to stay unset in state")
+	}
+	wantHashes := tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+		"admin": contactHash(contactModelToAWS(testContact("ops@example.com")), false),
+	})
+	if !state.ContactTemplateHashes.Equal(wantHashes) {
+		t.Errorf("Expected contact_template_hashes %s, got %s", wantHashes, state.ContactTemplateHashes)
+	}
+}
+
+func TestModifyPlan_contactTemplates(t *testing.T) {
+	templates := map[string]ContactModel{
+		"ops":   *testContact("ops@example.com"),
+		"legal": *testContact("legal@example.com"),
+	}
+	opsHash := contactHash(contactModelToAWS(testContact("ops@example.com")), false)
+
+	tests := []struct {
+		name       string
+		modify     func(m *DomainRegistrationResourceModel)
+		wantHashes tftypes.Map
+		wantErr    string
+	}{
+		{"no templates", func(m *DomainRegistrationResourceModel) {}, tftypes.MapNull(tftypes.StringType), ""},
+		{"template in effect", func(m *DomainRegistrationResourceModel) {
+			m.AdminContact = nil
+			m.AdminContactTemplate = stringValue("ops")
+		}, tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{"admin": opsHash}), ""},
+		{"overridden by the role", func(m *DomainRegistrationResourceModel) {
+			m.AdminContactTemplate = stringValue("ops")
+		}, tftypes.MapNull(tftypes.StringType), ""},
+		{"unknown name", func(m *DomainRegistrationResourceModel) {
+			m.TechContact = nil
+			m.TechContactTemplate = tftypes.StringUnknown()
+		}, tftypes.MapUnknown(tftypes.StringType), ""},
+		{"undefined template", func(m *DomainRegistrationResourceModel) {
+			m.TechContact = nil
+			m.TechContactTemplate = stringValue("billing")
+		}, tftypes.Map{}, "It defines legal, ops."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{contactTemplates: templates}
+
+			planned := testDomainModel("example.com")
+			planned.ContactTemplateHashes = tftypes.MapUnknown(tftypes.StringType)
+			tt.modify(planned)
+
+			plan := newResourcePlan(t, r, planned)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: newResourceState(t, r, nil), Plan: plan}, resp)
+
+			if tt.wantErr != "" {
+				if resp.Diagnostics.ErrorsCount() != 1 || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("Expected an error containing %q, got %v", tt.wantErr, resp.Diagnostics)
+				}
+				withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root("tech_contact_template")) {
+					t.Errorf("Expected the error on tech_contact_template, got %v", resp.Diagnostics.Errors()[0])
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+			var hashes tftypes.Map
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("contact_template_hashes"), &hashes)...)
+			if !hashes.Equal(tt.wantHashes) {
+				t.Errorf("Expected contact_template_hashes %s, got %s", tt.wantHashes, hashes)
+			}
+		})
+	}
+}
+
+func TestUpdate_contactTemplateChanged(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var contactInput *route53domains.UpdateDomainContactInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+			UpdateDomainContactFunc: func(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error) {
+				contactInput = params
+				return &route53domains.UpdateDomainContactOutput{}, nil
+			},
+		},
+		route53Client:    &MockRoute53Client{},
+		contactTemplates: map[string]ContactModel{"ops": *testContact("new-ops@example.com")},
+	}
+
+	// The state was applied while the template had the old email; both the
+	// plan and the state now resolve to the new one
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.AdminContact = nil
+	prior.AdminContactTemplate = stringValue("ops")
+	prior.ContactTemplateHashes = tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+		"admin": contactHash(contactModelToAWS(testContact("ops@example.com")), false),
+	})
+	planned := *prior
+	planned.ContactTemplateHashes = r.contactTemplateHashes(&planned)
+
+	resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  newResourcePlan(t, r, &planned),
+		State: newResourceState(t, r, prior),
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if contactInput == nil || contactInput.AdminContact == nil || aws.ToString(contactInput.AdminContact.Email) != "new-ops@example.com" {
+		t.Fatalf("Expected the changed admin template to be sent, got %+v", contactInput)
+	}
+	if contactInput.RegistrantContact != nil || contactInput.TechContact != nil {
+		t.Errorf("Expected the unchanged contacts to be omitted, got %+v", contactInput)
+	}
+}
+
+func TestModifyPlan_registrantTemplateChange(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client:           &MockRoute53DomainsClient{},
+		contactTemplates: map[string]ContactModel{"owner": *testContact("new-owner@example.com")},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.RegistrantContact = nil
+	prior.RegistrantContactTemplate = stringValue("owner")
+	prior.ContactTemplateHashes = tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+		"registrant": contactHash(contactModelToAWS(testContact("owner@example.com")), false),
+	})
+	planned := *prior
+	planned.ContactTemplateHashes = tftypes.MapUnknown(tftypes.StringType)
+
+	plan := newResourcePlan(t, r, &planned)
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: newResourceState(t, r, prior), Plan: plan}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Registrant change not allowed" {
+		t.Fatalf("Expected the registrant template change to be blocked, got %v", resp.Diagnostics)
+	}
+	withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("registrant_contact_template")) {
+		t.Errorf("Expected the error on registrant_contact_template, got %v", resp.Diagnostics.Errors()[0])
+	}
+}
+
+func TestCreate_billingContactFallback(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	tests := []struct {
+		domain string
+		want   []string
+	}{
+		{"example.ca", []string{"CA_LEGAL_TYPE", "VAT_NUMBER"}},
+		{"example.com", []string{"CA_LEGAL_TYPE"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			var input *route53domains.RegisterDomainInput
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+						input = params
+						return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+					},
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						detail := MockDomainDetailResponse(*params.DomainName)
+						detail.RegistrantContact = input.RegistrantContact
+						detail.RegistrantPrivacy = aws.Bool(false)
+						return detail, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			plan := testDomainModel(tt.domain)
+			plan.RegistrantContact.ExtraParams = map[string]tftypes.String{"CA_LEGAL_TYPE": stringValue("CCT")}
+			plan.BillingContact = testContact("billing@example.com")
+			plan.BillingContact.ExtraParams = map[string]tftypes.String{
+				"VAT_NUMBER":    stringValue("GB123456789"),
+				"CA_LEGAL_TYPE": stringValue("CCO"),
+			}
+
+			resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+			r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+
+			var names []string
+			for _, p := range input.RegistrantContact.ExtraParams {
+				names = append(names, string(p.Name))
+				if p.Name == types.ExtraParamNameCaLegalType && aws.ToString(p.Value) != "CCT" {
+					t.Errorf("Expected the registrant's own CA_LEGAL_TYPE to win, got %s", aws.ToString(p.Value))
+				}
+			}
+			if !slices.Equal(names, tt.want) {
+				t.Errorf("Expected registrant extra params %v, got %v", tt.want, names)
+			}
+			if len(input.BillingContact.ExtraParams) != 2 {
+				t.Errorf("Expected the billing contact to keep its extra params, got %v", input.BillingContact.ExtraParams)
+			}
+
+			// The carried params are not drift on the registrant
+			var state DomainRegistrationResourceModel
+			readResp := &resource.ReadResponse{State: resp.State}
+			r.Read(context.Background(), resource.ReadRequest{State: resp.State}, readResp)
+			readResp.Diagnostics.Append(readResp.State.Get(context.Background(), &state)...)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+			}
+			if got := slices.Sorted(maps.Keys(state.RegistrantContact.ExtraParams)); !slices.Equal(got, []string{"CA_LEGAL_TYPE"}) {
+				t.Errorf("Expected refreshed registrant extra params [CA_LEGAL_TYPE], got %v", got)
+			}
+		})
+	}
+}
+
+func TestCreate_skipDetailRefresh(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	tests := []struct {
+		name            string
+		skip            bool
+		wantDetailCalls int
+	}{
+		{"default", false, 1},
+		{"skipped", true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detailCalls := 0
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+						return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+					},
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						detailCalls++
+						return MockDomainDetailResponse(*params.DomainName), nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			plan := testDomainModel("example.com")
+			plan.SkipDetailRefresh = tftypes.BoolValue(tt.skip)
+
+			resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+			r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+
+			if detailCalls != tt.wantDetailCalls {
+				t.Errorf("Expected %d GetDomainDetail calls, got %d", tt.wantDetailCalls, detailCalls)
+			}
+
+			var state DomainRegistrationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if state.ID.ValueString() != "example.com" || state.AdminContact == nil {
+				t.Errorf("Expected state populated from the inputs, got id %s", state.ID)
+			}
+			if tt.skip && (!state.ExpirationDate.IsNull() || state.Status.ValueString() != "SUCCESSFUL") {
+				t.Errorf("Expected null expiration_date and the operation status, got %s and %s", state.ExpirationDate, state.Status)
+			}
+		})
+	}
+}
+
+func TestCreate_unicodeIDNPlansClean(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var requested []string
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				requested = append(requested, aws.ToString(params.DomainName))
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				requested = append(requested, aws.ToString(params.DomainName))
+				// AWS only knows IDNs by their punycode name
+				return MockDomainDetailResponse("xn--caf-dma.com"), nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	plan := testDomainModel("café.com")
+	createResp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+
+	for _, name := range requested {
+		if name != "xn--caf-dma.com" {
+			t.Errorf("Expected API calls to use the punycode name, got %q", name)
+		}
+	}
+
+	var state DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(context.Background(), &state)...)
+	if state.DomainName.ValueString() != "café.com" {
+		t.Errorf("Expected domain_name to keep its configured form, got %s", state.DomainName)
+	}
+	if state.ID.ValueString() != "xn--caf-dma.com" {
+		t.Errorf("Expected the punycode name as id, got %s", state.ID)
+	}
+
+	// A refresh that changes nothing leaves the next plan clean
+	var created DomainRegistrationResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(context.Background(), &created)...)
+	if created.DomainName != state.DomainName || created.ID != state.ID {
+		t.Errorf("Read changed the domain name: %s/%s -> %s/%s", created.DomainName, created.ID, state.DomainName, state.ID)
+	}
+}
+
+func TestDomainNameChanged(t *testing.T) {
+	tests := []struct {
+		state, plan string
+		want        bool
+	}{
+		{"xn--caf-dma.com", "café.com", false},
+		{"café.com", "xn--caf-dma.com", false},
+		{"Example.com", "example.com", false},
+		{"café.com", "cafe.com", true},
+	}
+	for _, tt := range tests {
+		req := planmodifier.StringRequest{
+			StateValue: tftypes.StringValue(tt.state),
+			PlanValue:  tftypes.StringValue(tt.plan),
+		}
+		resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+		domainNameChanged(context.Background(), req, resp)
+		if resp.RequiresReplace != tt.want {
+			t.Errorf("%s -> %s: expected RequiresReplace %v, got %v", tt.state, tt.plan, tt.want, resp.RequiresReplace)
+		}
+	}
+}
+
+func TestCreate_invalidContactTargetsAttribute(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return nil, &types.InvalidInput{Message: aws.String("Errors: [AdminContact.Email is invalid]")}
+			},
+		},
+	}
+
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainModel("example.com"))}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("Expected one error, got %v", resp.Diagnostics)
+	}
+	withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("Expected an attribute error, got %v", resp.Diagnostics.Errors()[0])
+	}
+	if want := path.Root("admin_contact").AtName("email"); !withPath.Path().Equal(want) {
+		t.Errorf("Expected the error on %s, got %s", want, withPath.Path())
+	}
+	if !strings.Contains(withPath.Detail(), "AdminContact.Email is invalid") {
+		t.Errorf("Expected the AWS message in the detail, got %q", withPath.Detail())
+	}
+}
+
+func TestBillingContact_roundTrip(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	var registered *route53domains.RegisterDomainInput
+	var updated *route53domains.UpdateDomainContactInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				registered = params
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.BillingContact = registered.BillingContact
+				detail.BillingPrivacy = aws.Bool(false)
+				return detail, nil
+			},
+			UpdateDomainContactFunc: func(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error) {
+				updated = params
+				return &route53domains.UpdateDomainContactOutput{}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	plan := testDomainModel("example.com")
+	plan.BillingContact = testContact("billing@example.com")
+	createResp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", createResp.Diagnostics)
+	}
+	if got := aws.ToString(registered.BillingContact.Email); got != "billing@example.com" {
+		t.Errorf("Expected the billing contact to be registered, got %q", got)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+	var state DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if !contactsEqual(state.BillingContact, plan.BillingContact) {
+		t.Errorf("Expected billing_contact to round-trip, got %+v", state.BillingContact)
+	}
+
+	planned := state
+	planned.BillingContact = testContact("accounts@example.com")
+	updateResp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &planned), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", updateResp.Diagnostics)
+	}
+	if updated == nil || aws.ToString(updated.BillingContact.Email) != "accounts@example.com" {
+		t.Fatalf("Expected the changed billing contact to be sent, got %+v", updated)
+	}
+	if updated.AdminContact != nil || updated.RegistrantContact != nil || updated.TechContact != nil {
+		t.Error("Expected only the billing contact to be sent")
+	}
+}
+
+func TestBillingContact_unsetStaysUnset(t *testing.T) {
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.BillingContact = &types.ContactDetail{Email: aws.String("registrar-default@example.com")}
+				return detail, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	state := newResourceState(t, r, prior)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var got DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &got)...)
+	if got.BillingContact != nil {
+		t.Errorf("Expected an unconfigured billing_contact to stay unset, got %+v", got.BillingContact)
+	}
+}
+
+func TestBillingPrivacy(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	var registered *route53domains.RegisterDomainInput
+	var privacy *route53domains.UpdateDomainContactPrivacyInput
+	awsBillingPrivacy := true
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				registered = params
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				detail := MockDomainDetailResponse(*params.DomainName)
+				detail.BillingPrivacy = aws.Bool(awsBillingPrivacy)
+				return detail, nil
+			},
+			UpdateDomainContactPrivacyFunc: func(ctx context.Context, params *route53domains.UpdateDomainContactPrivacyInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactPrivacyOutput, error) {
+				privacy = params
+				return &route53domains.UpdateDomainContactPrivacyOutput{}, nil
+			},
+		},
+		route53Client: &MockRoute53Client{},
+	}
+
+	// Unset, it is not sent on registration and not refreshed
+	createResp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainModel("example.com"))}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", createResp.Diagnostics)
+	}
+	if registered.PrivacyProtectBillingContact != nil {
+		t.Errorf("Expected no billing privacy when unset, got %v", *registered.PrivacyProtectBillingContact)
+	}
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	var state DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if !state.BillingPrivacy.IsNull() {
+		t.Errorf("Expected an unconfigured billing_privacy to stay null, got %s", state.BillingPrivacy)
+	}
+
+	// Set, it is registered with the domain
+	plan := testDomainModel("example.com")
+	plan.BillingPrivacy = tftypes.BoolValue(true)
+	createResp = &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", createResp.Diagnostics)
+	}
+	if !aws.ToBool(registered.PrivacyProtectBillingContact) {
+		t.Error("Expected billing privacy to be registered")
+	}
+
+	// Drift from AWS is picked up once configured
+	awsBillingPrivacy = false
+	readResp = &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if state.BillingPrivacy.IsNull() || state.BillingPrivacy.ValueBool() {
+		t.Errorf("Expected billing_privacy to refresh to false, got %s", state.BillingPrivacy)
+	}
+
+	// Changing it updates only the billing privacy
+	planned := state
+	planned.BillingPrivacy = tftypes.BoolValue(true)
+	updateResp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &planned), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", updateResp.Diagnostics)
+	}
+	if privacy == nil || !aws.ToBool(privacy.BillingPrivacy) {
+		t.Fatalf("Expected billing privacy to be updated, got %+v", privacy)
+	}
+	if privacy.AdminPrivacy != nil || privacy.RegistrantPrivacy != nil || privacy.TechPrivacy != nil {
+		t.Errorf("Expected only billing privacy to be sent, got %+v", privacy)
+	}
+
+	// Removing it leaves the AWS setting alone
+	privacy = nil
+	planned.BillingPrivacy = tftypes.BoolNull()
+	updateResp = &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &planned), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", updateResp.Diagnostics)
+	}
+	if privacy != nil {
+		t.Errorf("Expected no privacy update when billing_privacy is removed, got %+v", privacy)
+	}
+}
+
+func TestValidateConfig_contacts(t *testing.T) {
+	tests := []struct {
+		name       string
+		shared     bool
+		roles      []string
+		wantErrors int
+	}{
+		{"shared only", true, nil, 0},
+		{"all roles", false, []string{"admin", "registrant", "tech"}, 0},
+		{"shared with override", true, []string{"tech"}, 0},
+		{"missing tech", false, []string{"admin", "registrant"}, 1},
+		{"none", false, nil, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{}
+			model := testDomainModel("example.com")
+			model.AdminContact, model.RegistrantContact, model.TechContact = nil, nil, nil
+			if tt.shared {
+				model.Contact = testContact("shared@example.com")
+			}
+			for _, role := range tt.roles {
+				switch role {
+				case "admin":
+					model.AdminContact = testContact("admin@example.com")
+				case "registrant":
+					model.RegistrantContact = testContact("registrant@example.com")
+				case "tech":
+					model.TechContact = testContact("tech@example.com")
+				}
+			}
+
+			plan := newResourcePlan(t, r, model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("Expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_glueIPs(t *testing.T) {
+	tests := []struct {
+		name        string
+		nameservers []NameserverModel
+		wantErrors  int
+	}{
+		{"external", []NameserverModel{{Name: stringValue("ns1.example.net")}}, 0},
+		{"in-bailiwick with glue", []NameserverModel{{Name: stringValue("ns1.example.com"), GlueIPs: []tftypes.String{stringValue("192.0.2.1")}}}, 0},
+		{"in-bailiwick without glue", []NameserverModel{{Name: stringValue("ns1.example.com")}, {Name: stringValue("NS2.Example.com.")}}, 2},
+		{"similar suffix", []NameserverModel{{Name: stringValue("ns1.notexample.com")}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{}
+			model := testDomainModel("example.com")
+			model.Nameservers = tt.nameservers
+
+			plan := newResourcePlan(t, r, model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("Expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_extraParams(t *testing.T) {
+	tests := []struct {
+		name         string
+		domainName   string
+		countryCode  string
+		extraParams  map[string]tftypes.String
+		wantErrors   int
+		wantWarnings int
+	}{
+		{"ca missing legal type", "example.ca", "CA", nil, 1, 0},
+		{"ca with legal type", "example.ca", "CA", map[string]tftypes.String{"CA_LEGAL_TYPE": stringValue("CCO")}, 0, 0},
+		{"ca resident abroad", "example.ca", "US", map[string]tftypes.String{"CA_LEGAL_TYPE": stringValue("RES")}, 1, 0},
+		{"ca government abroad", "example.ca", "GB", map[string]tftypes.String{"CA_LEGAL_TYPE": stringValue("gov")}, 1, 0},
+		{"ca citizen abroad", "example.ca", "US", map[string]tftypes.String{"CA_LEGAL_TYPE": stringValue("CCT")}, 0, 0},
+		{"ca trademark owner abroad", "example.ca", "US", map[string]tftypes.String{"CA_LEGAL_TYPE": stringValue("TDM")}, 0, 0},
+		{"ca unknown legal type", "example.ca", "CA", map[string]tftypes.String{"CA_LEGAL_TYPE": stringValue("XYZ")}, 1, 0},
+		{"ca unknown legal type value", "example.ca", "US", map[string]tftypes.String{"CA_LEGAL_TYPE": tftypes.StringUnknown()}, 0, 0},
+		{"eu non-EU registrant", "example.eu", "US", nil, 0, 1},
+		{"eu non-EU citizen", "example.eu", "US", map[string]tftypes.String{"EU_COUNTRY_OF_CITIZENSHIP": stringValue("DE")}, 0, 0},
+		{"eu EU registrant", "example.eu", "DE", nil, 0, 0},
+		{"us", "example.us", "US", nil, 0, 0},
+		{"second-level TLD", "example.com.au", "AU", map[string]tftypes.String{"AU_ID_NUMBER": stringValue("123")}, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{}
+			model := testDomainModel(tt.domainName)
+			model.RegistrantContact.CountryCode = stringValue(tt.countryCode)
+			model.RegistrantContact.ExtraParams = tt.extraParams
+			// A postal code of the country, so only extra params warn
+			if zipCode, ok := map[string]string{"CA": "K1A 0B1", "GB": "SW1A 1AA", "AU": "2000", "DE": "10115"}[tt.countryCode]; ok {
+				model.RegistrantContact.ZipCode = stringValue(zipCode)
+			}
+
+			plan := newResourcePlan(t, r, model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("Expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tt.wantWarnings {
+				t.Errorf("Expected %d warnings, got %d: %v", tt.wantWarnings, got, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_organizationName(t *testing.T) {
+	tests := []struct {
+		name         string
+		contactType  tftypes.String
+		organization tftypes.String
+		wantErrors   int
+	}{
+		{"person", tftypes.StringNull(), tftypes.StringNull(), 0},
+		{"company with organization", stringValue("COMPANY"), stringValue("Example Corp"), 0},
+		{"company without organization", stringValue("COMPANY"), tftypes.StringNull(), 1},
+		{"association with empty organization", stringValue("ASSOCIATION"), stringValue(""), 1},
+		{"company with unknown organization", stringValue("COMPANY"), tftypes.StringUnknown(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{}
+			model := testDomainModel("example.com")
+			model.TechContact.ContactType = tt.contactType
+			model.TechContact.Organization = tt.organization
+
+			plan := newResourcePlan(t, r, model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Fatalf("Expected %d errors, got %d: %v", tt.wantErrors, got, resp.Diagnostics)
+			}
+			want := path.Root("tech_contact").AtName("organization_name")
+			for _, d := range resp.Diagnostics.Errors() {
+				if p, ok := d.(diag.DiagnosticWithPath); !ok || !p.Path().Equal(want) {
+					t.Errorf("Expected the error at %s, got %v", want, d)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConfig_zipCode(t *testing.T) {
+	tests := []struct {
+		name        string
+		countryCode string
+		zipCode     tftypes.String
+		wantWarning bool
+	}{
+		{"us five digits", "US", stringValue("98101"), false},
+		{"us zip+4", "US", stringValue("98101-1234"), false},
+		{"us nine digits", "US", stringValue("981011234"), false},
+		{"us too short", "US", stringValue("9810"), true},
+		{"us letters", "US", stringValue("SW1A 1AA"), true},
+		{"ca with space", "CA", stringValue("K1A 0B1"), false},
+		{"ca lowercase without space", "ca", stringValue("k1a0b1"), false},
+		{"ca digits", "CA", stringValue("98101"), true},
+		{"unlisted country", "IE", stringValue("D02 X285"), false},
+		{"unknown zip code", "US", tftypes.StringUnknown(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{}
+			model := testDomainModel("example.com")
+			model.RegistrantContact.CountryCode = stringValue(tt.countryCode)
+			model.RegistrantContact.ZipCode = tt.zipCode
+
+			plan := newResourcePlan(t, r, model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no errors, got %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() == 1; got != tt.wantWarning {
+				t.Fatalf("Expected warning=%v, got %v", tt.wantWarning, resp.Diagnostics)
+			}
+			want := path.Root("registrant_contact").AtName("zip_code")
+			for _, d := range resp.Diagnostics.Warnings() {
+				if p, ok := d.(diag.DiagnosticWithPath); !ok || !p.Path().Equal(want) {
+					t.Errorf("Expected the warning at %s, got %v", want, d)
+				}
+			}
+		})
+	}
+}
+
+func TestModifyPlan_validateAvailability(t *testing.T) {
+	tests := []struct {
+		name         string
+		validate     bool
+		existing     bool
+		availability types.DomainAvailability
+		wantCalls    int
+		wantErr      bool
+	}{
+		{"available", true, false, types.DomainAvailabilityAvailable, 1, false},
+		{"unavailable", true, false, types.DomainAvailabilityUnavailable, 1, true},
+		{"disabled", false, false, types.DomainAvailabilityUnavailable, 0, false},
+		{"existing domain", true, true, types.DomainAvailabilityUnavailable, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					CheckDomainAvailabilityFunc: func(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error) {
+						calls++
+						if aws.ToString(params.DomainName) != "example.com" {
+							t.Errorf("Unexpected domain %s", aws.ToString(params.DomainName))
+						}
+						return &route53domains.CheckDomainAvailabilityOutput{Availability: tt.availability}, nil
+					},
+				},
+			}
+
+			model := testDomainModel("example.com")
+			model.ValidateAvailability = tftypes.BoolValue(tt.validate)
+			state := newResourceState(t, r, nil)
+			if tt.existing {
+				state = newResourceState(t, r, model)
+			}
+			plan := newResourcePlan(t, r, model)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+
+			if calls != tt.wantCalls {
+				t.Errorf("Expected %d availability checks, got %d", tt.wantCalls, calls)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("Expected error=%v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestModifyPlan_registrantChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(m *DomainRegistrationResourceModel)
+		allow       bool
+		wantErr     bool
+		wantWarning bool
+		wantPath    path.Path
+	}{
+		{"unchanged", func(m *DomainRegistrationResourceModel) {}, false, false, false, path.Path{}},
+		{"other contact changed", func(m *DomainRegistrationResourceModel) {
+			m.AdminContact = testContact("new-admin@example.com")
+		}, false, false, false, path.Path{}},
+		{"blocked", func(m *DomainRegistrationResourceModel) {
+			m.RegistrantContact = testContact("new@example.com")
+		}, false, true, false, path.Root("registrant_contact")},
+		{"allowed", func(m *DomainRegistrationResourceModel) {
+			m.RegistrantContact = testContact("new@example.com")
+		}, true, false, true, path.Root("registrant_contact")},
+		{"blocked via shared contact", func(m *DomainRegistrationResourceModel) {
+			m.RegistrantContact = nil
+			m.Contact = testContact("shared@example.com")
+		}, false, true, false, path.Root("contact")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &DomainRegistrationResource{client: &MockRoute53DomainsClient{}}
+
+			prior := testDomainModel("example.com")
+			planned := testDomainModel("example.com")
+			tt.modify(planned)
+			planned.AllowRegistrantChange = tftypes.BoolValue(tt.allow)
+
+			plan := newResourcePlan(t, r, planned)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: newResourceState(t, r, prior), Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("Expected error=%v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Fatalf("Expected warning=%v, got %v", tt.wantWarning, resp.Diagnostics)
+			}
+			for _, d := range resp.Diagnostics {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(tt.wantPath) {
+					t.Errorf("Expected diagnostic on %s, got %v", tt.wantPath, d)
+				}
+			}
+			if tt.wantErr {
+				if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Registrant change not allowed" {
+					t.Errorf("Unexpected diagnostic summary: %s", summary)
+				}
+			}
+		})
+	}
+}
+
+// setOperationPollInterval overrides the operation poll interval and returns
+// a func restoring the previous value.
+func setOperationPollInterval(d time.Duration) func() {
+	previous := operationPollInterval
+	operationPollInterval = d
+	return func() { operationPollInterval = previous }
+}
+
+// testContact returns a fully populated contact for tests.
+func testContact(email string) *ContactModel {
+	return &ContactModel{
+		FirstName:    stringValue("John"),
+		LastName:     stringValue("Doe"),
+		Email:        stringValue(email),
+		PhoneNumber:  stringValue("+1.5551234567"),
+		AddressLine1: stringValue("123 Main St"),
+		City:         stringValue("Seattle"),
+		State:        stringValue("WA"),
+		ZipCode:      stringValue("98101"),
+		CountryCode:  stringValue("US"),
+	}
+}
+
+// testDomainModel returns a resource model with schema defaults applied, as it
+// would appear in a plan for a minimal configuration.
+func testDomainModel(domainName string) *DomainRegistrationResourceModel {
+	return &DomainRegistrationResourceModel{
+		ID:                      tftypes.StringUnknown(),
+		DomainName:              stringValue(domainName),
+		DurationYears:           tftypes.Int64Value(1),
+		AutoRenew:               tftypes.BoolValue(false),
+		AdminContact:            testContact("admin@example.com"),
+		RegistrantContact:       testContact("registrant@example.com"),
+		TechContact:             testContact("tech@example.com"),
+		AdminPrivacy:            tftypes.BoolValue(true),
+		RegistrantPrivacy:       tftypes.BoolValue(true),
+		TechPrivacy:             tftypes.BoolValue(true),
+		AllowDelete:             tftypes.BoolValue(false),
+		DeleteHostedZone:        tftypes.BoolValue(false),
+		ManageHostedZone:        tftypes.BoolValue(true),
+		RegistrarZoneComments:   []tftypes.String{tftypes.StringValue(registrarZoneComment)},
+		Status:                  tftypes.StringUnknown(),
+		ExpirationDate:          tftypes.StringUnknown(),
+		DaysUntilExpiry:         tftypes.Int64Unknown(),
+		CreationDate:            tftypes.StringUnknown(),
+		RegistrationTimeout:     tftypes.Int64Value(900),
+		DeleteTimeout:           tftypes.Int64Value(900),
+		UnlockBeforeDelete:      tftypes.BoolValue(false),
+		HostedZoneID:            tftypes.StringUnknown(),
+		RegistrarNameservers:    tftypes.ListUnknown(tftypes.StringType),
+		OperationID:             tftypes.StringUnknown(),
+		ReachabilityStatus:      tftypes.StringUnknown(),
+		AdminContactHash:        tftypes.StringUnknown(),
+		RegistrantContactHash:   tftypes.StringUnknown(),
+		TechContactHash:         tftypes.StringUnknown(),
+		WhoisPrivacyEffective:   tftypes.MapUnknown(tftypes.BoolType),
+		TagsAll:                 tftypes.MapNull(tftypes.StringType),
+		ContactTemplateHashes:   tftypes.MapNull(tftypes.StringType),
+		Reseller:                tftypes.StringUnknown(),
+		WhoIsServer:             tftypes.StringUnknown(),
+		RegistrarName:           tftypes.StringUnknown(),
+		Registrar:               tftypes.StringUnknown(),
+		RegistrarURL:            tftypes.StringUnknown(),
+		AbuseContactEmail:       tftypes.StringUnknown(),
+		AbuseContactPhone:       tftypes.StringUnknown(),
+		ResendReachabilityEmail: tftypes.BoolValue(false),
+		ValidateAvailability:    tftypes.BoolValue(false),
+		AllowRegistrantChange:   tftypes.BoolValue(false),
+		SkipDetailRefresh:       tftypes.BoolValue(false),
+	}
+}
+
+// Helper to create terraform string values for testing
+func stringValue(s string) tftypes.String {
+	return tftypes.StringValue(s)
+}
+
+// MockDomainDetailResponse creates a mock GetDomainDetailOutput
+func MockDomainDetailResponse(domainName string) *route53domains.GetDomainDetailOutput {
+	now := time.Now()
+	expiry := now.AddDate(1, 0, 0)
+	return &route53domains.GetDomainDetailOutput{
+		DomainName:     aws.String(domainName),
+		AutoRenew:      aws.Bool(false),
+		CreationDate:   aws.Time(now),
+		ExpirationDate: aws.Time(expiry),
+		StatusList:     []string{"ok"},
+		Nameservers: []types.Nameserver{
+			{Name: aws.String("ns1.example.com")},
+			{Name: aws.String("ns2.example.com")},
+		},
+		AdminContact: &types.ContactDetail{
+			FirstName:   aws.String("John"),
+			LastName:    aws.String("Doe"),
+			Email:       aws.String("admin@example.com"),
+			PhoneNumber: aws.String("+1.5551234567"),
+		},
+		RegistrantContact: &types.ContactDetail{
+			FirstName:   aws.String("John"),
+			LastName:    aws.String("Doe"),
+			Email:       aws.String("registrant@example.com"),
+			PhoneNumber: aws.String("+1.5551234567"),
+		},
+		TechContact: &types.ContactDetail{
+			FirstName:   aws.String("John"),
+			LastName:    aws.String("Doe"),
+			Email:       aws.String("tech@example.com"),
+			PhoneNumber: aws.String("+1.5551234567"),
+		},
+		AdminPrivacy:      aws.Bool(true),
+		RegistrantPrivacy: aws.Bool(true),
+		TechPrivacy:       aws.Bool(true),
+	}
+}
+
+func TestCreate_correctsAutoRenewIgnoredByRegistry(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	tests := []struct {
+		name        string
+		configured  bool
+		registered  bool
+		enableErr   error
+		wantEnable  int
+		wantDisable int
+		wantWarning bool
+	}{
+		{name: "matching", configured: true, registered: true},
+		{name: "enabled when registry disabled it", configured: true, registered: false, wantEnable: 1},
+		{name: "disabled when registry enabled it", configured: false, registered: true, wantDisable: 1},
+		{name: "failure warns", configured: true, registered: false, enableErr: errors.New("boom"), wantEnable: 1, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var enabled, disabled int
+			r := &DomainRegistrationResource{
+				client: &MockRoute53DomainsClient{
+					RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+						return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+					},
+					GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+						return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+					},
+					GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+						detail := MockDomainDetailResponse(*params.DomainName)
+						detail.AutoRenew = aws.Bool(tt.registered)
+						return detail, nil
+					},
+					EnableDomainAutoRenewFunc: func(ctx context.Context, params *route53domains.EnableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.EnableDomainAutoRenewOutput, error) {
+						enabled++
+						return &route53domains.EnableDomainAutoRenewOutput{}, tt.enableErr
+					},
+					DisableDomainAutoRenewFunc: func(ctx context.Context, params *route53domains.DisableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainAutoRenewOutput, error) {
+						disabled++
+						return &route53domains.DisableDomainAutoRenewOutput{}, nil
+					},
+				},
+				route53Client: &MockRoute53Client{},
+			}
+
+			plan := testDomainModel("example.com")
+			plan.AutoRenew = tftypes.BoolValue(tt.configured)
+			plan.ManageHostedZone = tftypes.BoolValue(false)
+			resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+			r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+
+			if enabled != tt.wantEnable || disabled != tt.wantDisable {
+				t.Errorf("Expected %d enable and %d disable calls, got %d and %d", tt.wantEnable, tt.wantDisable, enabled, disabled)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("Expected warning %t, got %v", tt.wantWarning, resp.Diagnostics)
+			}
+			var autoRenew tftypes.Bool
+			resp.State.GetAttribute(context.Background(), path.Root("auto_renew"), &autoRenew)
+			if autoRenew.ValueBool() != tt.configured {
+				t.Errorf("Expected auto_renew %t in state, got %s", tt.configured, autoRenew)
+			}
+		})
+	}
+}
+
+func TestCreate_tagsHostedZone(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	var tagInputs []*route53.ChangeTagsForResourceInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			RegisterDomainFunc: func(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error) {
+				return &route53domains.RegisterDomainOutput{OperationId: aws.String("op-register")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return &route53domains.GetOperationDetailOutput{Status: types.OperationStatusSuccessful}, nil
+			},
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client: &MockRoute53Client{
+			ListHostedZonesByNameFunc: func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+				return &route53.ListHostedZonesByNameOutput{
+					HostedZones: []route53types.HostedZone{{Id: aws.String("/hostedzone/Z123"), Name: aws.String("example.com.")}},
+				}, nil
+			},
+			ChangeTagsForResourceFunc: func(ctx context.Context, params *route53.ChangeTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ChangeTagsForResourceOutput, error) {
+				tagInputs = append(tagInputs, params)
+				return &route53.ChangeTagsForResourceOutput{}, nil
+			},
+		},
+	}
+
+	plan := testDomainModel("example.com")
+	plan.Tags = map[string]tftypes.String{"team": stringValue("web"), "cost-center": stringValue("42")}
+
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if len(tagInputs) != 1 {
+		t.Fatalf("Expected one ChangeTagsForResource call, got %d", len(tagInputs))
+	}
+	input := tagInputs[0]
+	if input.ResourceType != route53types.TagResourceTypeHostedzone || aws.ToString(input.ResourceId) != "Z123" {
+		t.Errorf("Expected hosted zone Z123 to be tagged, got %s %s", input.ResourceType, aws.ToString(input.ResourceId))
+	}
+	want := []route53types.Tag{
+		{Key: aws.String("cost-center"), Value: aws.String("42")},
+		{Key: aws.String("team"), Value: aws.String("web")},
+	}
+	if !reflect.DeepEqual(input.AddTags, want) || len(input.RemoveTagKeys) != 0 {
+		t.Errorf("Expected tags %v to be added, got %+v", want, input)
+	}
+}
+
+func TestReadUpdate_reconcilesZoneTags(t *testing.T) {
+	ctx := context.Background()
+	zoneTags := map[string]string{"team": "platform", "owner": "ops"}
+	var tagInput *route53.ChangeTagsForResourceInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client: &MockRoute53Client{
+			ListHostedZonesByNameFunc: func(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+				return &route53.ListHostedZonesByNameOutput{
+					HostedZones: []route53types.HostedZone{{Id: aws.String("/hostedzone/Z123"), Name: aws.String("example.com.")}},
+				}, nil
+			},
+			ListTagsForResourceFunc: func(ctx context.Context, params *route53.ListTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ListTagsForResourceOutput, error) {
+				set := &route53types.ResourceTagSet{ResourceId: params.ResourceId, ResourceType: params.ResourceType}
+				for k, v := range zoneTags {
+					set.Tags = append(set.Tags, route53types.Tag{Key: aws.String(k), Value: aws.String(v)})
+				}
+				return &route53.ListTagsForResourceOutput{ResourceTagSet: set}, nil
+			},
+			ChangeTagsForResourceFunc: func(ctx context.Context, params *route53.ChangeTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ChangeTagsForResourceOutput, error) {
+				tagInput = params
+				return &route53.ChangeTagsForResourceOutput{}, nil
+			},
+		},
+	}
+
+	// The zone's team tag was changed and its env tag removed out of band;
+	// owner was never configured
+	prior := testDomainModel("example.com")
+	prior.ID = stringValue("example.com")
+	prior.Tags = map[string]tftypes.String{"team": stringValue("web"), "env": stringValue("prod")}
+	state := newResourceState(t, r, prior)
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+	var refreshed DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &refreshed)...)
+	if want := map[string]tftypes.String{"team": stringValue("platform")}; !reflect.DeepEqual(refreshed.Tags, want) {
+		t.Fatalf("Expected refreshed tags %v, got %v", want, refreshed.Tags)
+	}
+
+	// Applying the configuration again restores team and env and drops cost
+	planned := refreshed
+	planned.Tags = map[string]tftypes.String{"team": stringValue("web"), "env": stringValue("prod")}
+	refreshed.Tags["cost"] = stringValue("1")
+	updateResp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &planned), State: newResourceState(t, r, &refreshed)}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", updateResp.Diagnostics)
+	}
+	if tagInput == nil {
+		t.Fatal("Expected ChangeTagsForResource to be called")
+	}
+	want := []route53types.Tag{
+		{Key: aws.String("env"), Value: aws.String("prod")},
+		{Key: aws.String("team"), Value: aws.String("web")},
+	}
+	if !reflect.DeepEqual(tagInput.AddTags, want) || !slices.Equal(tagInput.RemoveTagKeys, []string{"cost"}) {
+		t.Errorf("Expected to add %v and remove [cost], got %+v", want, tagInput)
+	}
+}
+
+func TestModifyPlan_defaultTagsMerge(t *testing.T) {
+	r := &DomainRegistrationResource{defaultTags: map[string]string{"team": "platform", "env": "dev"}}
+
+	tests := []struct {
+		name string
+		tags map[string]tftypes.String
+		want tftypes.Map
+	}{
+		{"defaults only", nil, tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+			"team": stringValue("platform"),
+			"env":  stringValue("dev"),
+		})},
+		{"resource tag wins", map[string]tftypes.String{"team": stringValue("web"), "app": stringValue("shop")}, tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+			"team": stringValue("web"),
+			"env":  stringValue("dev"),
+			"app":  stringValue("shop"),
+		})},
+		{"unknown resource tag", map[string]tftypes.String{"env": tftypes.StringUnknown()}, tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+			"team": stringValue("platform"),
+			"env":  tftypes.StringUnknown(),
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := testDomainModel("example.com")
+			model.Tags = tt.tags
+			model.TagsAll = tftypes.MapUnknown(tftypes.StringType)
+			plan := newResourcePlan(t, r, model)
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: newResourceState(t, r, nil), Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan returned errors: %v", resp.Diagnostics)
+			}
+
+			var got tftypes.Map
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("tags_all"), &got)...)
+			if !got.Equal(tt.want) {
+				t.Errorf("Expected tags_all %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// Without tags or default tags there is nothing to apply
+	r.defaultTags = nil
+	plan := newResourcePlan(t, r, testDomainModel("example.com"))
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: newResourceState(t, r, nil), Plan: plan}, resp)
+	var got tftypes.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("tags_all"), &got)...)
+	if !got.IsNull() {
+		t.Errorf("Expected null tags_all, got %v", got)
+	}
+}
+
+func TestUpdate_defaultTagsChange(t *testing.T) {
+	ctx := context.Background()
+	var tagInput *route53.ChangeTagsForResourceInput
+	r := &DomainRegistrationResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return MockDomainDetailResponse(*params.DomainName), nil
+			},
+		},
+		route53Client: &MockRoute53Client{
+			ChangeTagsForResourceFunc: func(ctx context.Context, params *route53.ChangeTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ChangeTagsForResourceOutput, error) {
+				tagInput = params
+				return &route53.ChangeTagsForResourceOutput{}, nil
+			},
+		},
+		defaultTags: map[string]string{"team": "platform"},
+	}
+
+	// The provider dropped the owner default and added team, which the
+	// resource's own team tag overrides
+	state := testDomainModel("example.com")
+	state.HostedZoneID = stringValue("Z123")
+	state.RegistrarNameservers = tftypes.ListNull(tftypes.StringType)
+	state.WhoisPrivacyEffective = tftypes.MapNull(tftypes.BoolType)
+	state.Tags = map[string]tftypes.String{"team": stringValue("web")}
+	state.TagsAll = tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{
+		"team":  stringValue("web"),
+		"owner": stringValue("ops"),
+	})
+	plan := *state
+	plan.TagsAll = tftypes.MapUnknown(tftypes.StringType)
+
+	resp := &resource.UpdateResponse{State: newResourceState(t, r, nil)}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &plan), State: newResourceState(t, r, state)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+	}
+	if tagInput == nil {
+		t.Fatal("Expected ChangeTagsForResource to be called")
+	}
+	want := []route53types.Tag{{Key: aws.String("team"), Value: aws.String("web")}}
+	if !reflect.DeepEqual(tagInput.AddTags, want) || !slices.Equal(tagInput.RemoveTagKeys, []string{"owner"}) {
+		t.Errorf("Expected to add %v and remove [owner], got %+v", want, tagInput)
+	}
+
+	var updated DomainRegistrationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &updated)...)
+	if wantAll := tftypes.MapValueMust(tftypes.StringType, map[string]attr.Value{"team": stringValue("web")}); !updated.TagsAll.Equal(wantAll) {
+		t.Errorf("Expected tags_all %v, got %v", wantAll, updated.TagsAll)
+	}
+}
+
+func TestTagHostedZone_batches(t *testing.T) {
+	var calls []*route53.ChangeTagsForResourceInput
+	r := &DomainRegistrationResource{route53Client: &MockRoute53Client{
+		ChangeTagsForResourceFunc: func(ctx context.Context, params *route53.ChangeTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ChangeTagsForResourceOutput, error) {
+			calls = append(calls, params)
+			return &route53.ChangeTagsForResourceOutput{}, nil
+		},
+	}}
+
+	tags := map[string]tftypes.String{}
+	for i := range 12 {
+		tags[fmt.Sprintf("key-%02d", i)] = stringValue("v")
+	}
+	if err := r.tagHostedZone(context.Background(), "Z123", tags, map[string]tftypes.String{"old": stringValue("v")}); err != nil {
+		t.Fatalf("tagHostedZone returned error: %v", err)
+	}
+
+	if len(calls) != 2 || len(calls[0].AddTags) != maxTagChanges || len(calls[1].AddTags) != 2 {
+		t.Fatalf("Expected 12 tags added over 2 calls, got %d calls", len(calls))
+	}
+	if !slices.Equal(calls[0].RemoveTagKeys, []string{"old"}) || len(calls[1].RemoveTagKeys) != 0 {
+		t.Errorf("Expected old to be removed once, got %v and %v", calls[0].RemoveTagKeys, calls[1].RemoveTagKeys)
 	}
 }
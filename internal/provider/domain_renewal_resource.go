@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainRenewalResource{}
+
+// renewalTimeout bounds how long Create waits for the RenewDomain operation.
+const renewalTimeout = 15 * time.Minute
+
+type DomainRenewalResource struct {
+	client Route53DomainsAPI
+}
+
+type DomainRenewalResourceModel struct {
+	ID                tftypes.String `tfsdk:"id"`
+	DomainName        tftypes.String `tfsdk:"domain_name"`
+	DurationYears     tftypes.Int64  `tfsdk:"duration_years"`
+	CurrentExpiryYear tftypes.Int64  `tfsdk:"current_expiry_year"`
+	OperationID       tftypes.String `tfsdk:"operation_id"`
+	ExpirationDate    tftypes.String `tfsdk:"expiration_date"`
+}
+
+func NewDomainRenewalResource() resource.Resource {
+	return &DomainRenewalResource{}
+}
+
+func (r *DomainRenewalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_renewal"
+}
+
+func (r *DomainRenewalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renews a domain registered in this account for a number of years, once. Nothing is renewed if the domain's expiry already reflects the renewal. Change current_expiry_year or duration_years to renew again. Destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The domain name (used as the resource ID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name to renew.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration_years": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of years to renew the domain for.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"current_expiry_year": schema.Int64Attribute{
+				Required:    true,
+				Description: "The year the domain expires in before the renewal. RenewDomain requires it to guard against renewing twice.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the renewal operation, or null if no renewal was needed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The domain's expiration date (RFC3339).",
+			},
+		},
+	}
+}
+
+func (r *DomainRenewalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.DomainsClient
+}
+
+func (r *DomainRenewalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainRenewalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := canonicalDomainName(data.DomainName.ValueString())
+	years := data.DurationYears.ValueInt64()
+	currentYear := data.CurrentExpiryYear.ValueInt64()
+
+	expiration, err := r.expirationDate(ctx, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain expiry",
+			fmt.Sprintf("Could not read the expiration date of %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	data.ID = tftypes.StringValue(domainName)
+	data.OperationID = tftypes.StringNull()
+	data.ExpirationDate = tftypes.StringValue(formatTimestamp(expiration))
+
+	expiryYear := int64(expiration.Year())
+	switch {
+	case expiryYear >= currentYear+years:
+		// Already renewed, e.g. by an earlier apply whose state was lost
+		tflog.Info(ctx, "Domain expiry already reflects the renewal, skipping RenewDomain", map[string]interface{}{
+			"domain":      domainName,
+			"expiry_year": expiryYear,
+		})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	case expiryYear != currentYear:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("current_expiry_year"),
+			"Unexpected current expiry year",
+			fmt.Sprintf("%s expires in %d, not %d, and has not been renewed for %d year(s) from %d. Set current_expiry_year to %d to renew from the current expiry.", domainName, expiryYear, currentYear, years, currentYear, expiryYear),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Renewing domain", map[string]interface{}{
+		"domain": domainName,
+		"years":  years,
+	})
+
+	output, err := r.client.RenewDomain(ctx, &route53domains.RenewDomainInput{
+		DomainName:        aws.String(domainName),
+		DurationInYears:   aws.Int32(int32(years)),
+		CurrentExpiryYear: int32(currentYear),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error renewing domain",
+			fmt.Sprintf("Could not renew %s for %d year(s): %s", domainName, years, err.Error()),
+		)
+		return
+	}
+
+	operationID := aws.ToString(output.OperationId)
+	data.OperationID = tftypes.StringPointerValue(output.OperationId)
+
+	opDetail, err := waitForOperation(ctx, r.client, operationID, renewalTimeout)
+	switch {
+	case errors.Is(err, errWaitTimeout):
+		resp.Diagnostics.AddWarning(
+			"Domain renewal still in progress",
+			fmt.Sprintf("Renewal of %s (operation %s) did not complete within %s. The new expiration date will be picked up on the next refresh.", domainName, operationID, waitLimit(err, renewalTimeout)),
+		)
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error checking renewal status",
+			fmt.Sprintf("Could not check renewal status for %s: %s", domainName, err.Error()),
+		)
+		return
+	case opDetail.Status != types.OperationStatusSuccessful:
+		resp.Diagnostics.AddError(
+			"Domain renewal failed",
+			fmt.Sprintf("Renewal of %s finished with status %s: %s", domainName, opDetail.Status, aws.ToString(opDetail.Message)),
+		)
+		return
+	default:
+		if expiration, err := r.expirationDate(ctx, domainName); err == nil {
+			data.ExpirationDate = tftypes.StringValue(formatTimestamp(expiration))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRenewalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainRenewalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.ID.ValueString()
+
+	expiration, err := r.expirationDate(ctx, domainName)
+	if err != nil {
+		if isDomainNotFound(err) {
+			tflog.Warn(ctx, "Domain not found, removing renewal from state", map[string]interface{}{
+				"domain": domainName,
+				"error":  err.Error(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading domain expiry",
+			fmt.Sprintf("Could not read the expiration date of %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	data.ExpirationDate = tftypes.StringValue(formatTimestamp(expiration))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRenewalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument forces replacement, so there is nothing to update in place.
+	var data DomainRenewalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRenewalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainRenewalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Domain renewal will be removed from state only", map[string]interface{}{
+		"domain": data.ID.ValueString(),
+	})
+}
+
+// expirationDate returns the domain's current expiration date.
+func (r *DomainRenewalResource) expirationDate(ctx context.Context, domainName string) (time.Time, error) {
+	detail, err := r.client.GetDomainDetail(ctx, &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domainName),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if detail.ExpirationDate == nil {
+		return time.Time{}, fmt.Errorf("no expiration date reported for %s", domainName)
+	}
+	return *detail.ExpirationDate, nil
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDomainRenewalModel() *DomainRenewalResourceModel {
+	return &DomainRenewalResourceModel{
+		ID:                tftypes.StringUnknown(),
+		DomainName:        tftypes.StringValue("example.com"),
+		DurationYears:     tftypes.Int64Value(2),
+		CurrentExpiryYear: tftypes.Int64Value(2027),
+		OperationID:       tftypes.StringUnknown(),
+		ExpirationDate:    tftypes.StringUnknown(),
+	}
+}
+
+func TestDomainRenewalCreate(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	expiry := time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC)
+	var input *route53domains.RenewDomainInput
+	r := &DomainRenewalResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return &route53domains.GetDomainDetailOutput{DomainName: params.DomainName, ExpirationDate: aws.Time(expiry)}, nil
+			},
+			RenewDomainFunc: func(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error) {
+				input = params
+				return &route53domains.RenewDomainOutput{OperationId: aws.String("op-renew")}, nil
+			},
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				expiry = expiry.AddDate(2, 0, 0)
+				return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: types.OperationStatusSuccessful}, nil
+			},
+		},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainRenewalModel())}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if input == nil || aws.ToString(input.DomainName) != "example.com" || aws.ToInt32(input.DurationInYears) != 2 || input.CurrentExpiryYear != 2027 {
+		t.Fatalf("Unexpected RenewDomain input: %+v", input)
+	}
+
+	var state DomainRenewalResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.OperationID.ValueString() != "op-renew" {
+		t.Errorf("Expected operation_id op-renew, got %s", state.OperationID)
+	}
+	if state.ExpirationDate.ValueString() != "2029-06-01T00:00:00Z" {
+		t.Errorf("Expected the renewed expiration date, got %s", state.ExpirationDate)
+	}
+}
+
+func TestDomainRenewalCreate_alreadyRenewed(t *testing.T) {
+	ctx := context.Background()
+
+	r := &DomainRenewalResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return &route53domains.GetDomainDetailOutput{DomainName: params.DomainName, ExpirationDate: aws.Time(time.Date(2029, 6, 1, 0, 0, 0, 0, time.UTC))}, nil
+			},
+			RenewDomainFunc: func(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error) {
+				t.Error("RenewDomain should not be called when the expiry already reflects the renewal")
+				return &route53domains.RenewDomainOutput{}, nil
+			},
+		},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainRenewalModel())}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	var state DomainRenewalResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if !state.OperationID.IsNull() {
+		t.Errorf("Expected no operation_id, got %s", state.OperationID)
+	}
+	if state.ExpirationDate.ValueString() != "2029-06-01T00:00:00Z" {
+		t.Errorf("Unexpected expiration date %s", state.ExpirationDate)
+	}
+}
+
+func TestDomainRenewalCreate_expiryYearMismatch(t *testing.T) {
+	r := &DomainRenewalResource{
+		client: &MockRoute53DomainsClient{
+			GetDomainDetailFunc: func(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error) {
+				return &route53domains.GetDomainDetailOutput{DomainName: params.DomainName, ExpirationDate: aws.Time(time.Date(2028, 6, 1, 0, 0, 0, 0, time.UTC))}, nil
+			},
+			RenewDomainFunc: func(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error) {
+				t.Error("RenewDomain should not be called with a stale current_expiry_year")
+				return &route53domains.RenewDomainOutput{}, nil
+			},
+		},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testDomainRenewalModel())}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error diagnostic")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Unexpected current expiry year" {
+		t.Errorf("Unexpected diagnostic summary: %s", summary)
+	}
+}
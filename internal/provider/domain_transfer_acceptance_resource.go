@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DomainTransferAcceptanceResource{}
+
+type DomainTransferAcceptanceResource struct {
+	client Route53DomainsAPI
+}
+
+type DomainTransferAcceptanceResourceModel struct {
+	ID              tftypes.String `tfsdk:"id"`
+	DomainName      tftypes.String `tfsdk:"domain_name"`
+	Password        tftypes.String `tfsdk:"password"`
+	Reject          tftypes.Bool   `tfsdk:"reject"`
+	TransferTimeout tftypes.Int64  `tfsdk:"transfer_timeout"`
+	OperationID     tftypes.String `tfsdk:"operation_id"`
+	Status          tftypes.String `tfsdk:"status"`
+}
+
+func NewDomainTransferAcceptanceResource() resource.Resource {
+	return &DomainTransferAcceptanceResource{}
+}
+
+func (r *DomainTransferAcceptanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_transfer_acceptance"
+}
+
+func (r *DomainTransferAcceptanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Accepts (or rejects) a domain transfer initiated from another AWS account. Destroying this resource only removes it from state; an accepted transfer cannot be undone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The domain name (used as the resource ID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name being transferred to this account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password generated by the sending account. Required unless reject is true.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reject": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Reject the transfer instead of accepting it.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"transfer_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(900),
+				Description: "Timeout in seconds to wait for the accept or reject operation to complete (default: 900 = 15 minutes).",
+			},
+			"operation_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the accept or reject operation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the accept or reject operation.",
+			},
+		},
+	}
+}
+
+func (r *DomainTransferAcceptanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.DomainsClient
+}
+
+func (r *DomainTransferAcceptanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainTransferAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.DomainName.ValueString()
+	action := "accept"
+	if data.Reject.ValueBool() {
+		action = "reject"
+	}
+
+	var operationID *string
+	if data.Reject.ValueBool() {
+		tflog.Info(ctx, "Rejecting domain transfer from another AWS account", map[string]interface{}{
+			"domain": domainName,
+		})
+
+		output, err := r.client.RejectDomainTransferFromAnotherAwsAccount(ctx, &route53domains.RejectDomainTransferFromAnotherAwsAccountInput{
+			DomainName: aws.String(domainName),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error rejecting domain transfer",
+				fmt.Sprintf("Could not reject transfer of %s: %s", domainName, err.Error()),
+			)
+			return
+		}
+		operationID = output.OperationId
+	} else {
+		if data.Password.IsNull() || data.Password.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Missing transfer password",
+				fmt.Sprintf("A password is required to accept the transfer of %s.", domainName),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Accepting domain transfer from another AWS account", map[string]interface{}{
+			"domain": domainName,
+		})
+
+		output, err := r.client.AcceptDomainTransferFromAnotherAwsAccount(ctx, &route53domains.AcceptDomainTransferFromAnotherAwsAccountInput{
+			DomainName: aws.String(domainName),
+			Password:   aws.String(data.Password.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error accepting domain transfer",
+				fmt.Sprintf("Could not accept transfer of %s: %s", domainName, err.Error()),
+			)
+			return
+		}
+		operationID = output.OperationId
+	}
+
+	data.ID = tftypes.StringValue(domainName)
+	data.OperationID = tftypes.StringPointerValue(operationID)
+	data.Status = tftypes.StringValue(string(types.OperationStatusSubmitted))
+
+	timeout := time.Duration(data.TransferTimeout.ValueInt64()) * time.Second
+	opDetail, err := waitForOperation(ctx, r.client, aws.ToString(operationID), timeout)
+	if opDetail != nil {
+		data.Status = tftypes.StringValue(string(opDetail.Status))
+	}
+	switch {
+	case errors.Is(err, errWaitTimeout):
+		resp.Diagnostics.AddWarning(
+			"Transfer operation still in progress",
+			fmt.Sprintf("The %s operation %s for %s did not complete within %s. Its status will be refreshed on the next plan.", action, aws.ToString(operationID), domainName, waitLimit(err, timeout)),
+		)
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error waiting for transfer operation",
+			fmt.Sprintf("Could not wait for %s operation %s for %s: %s", action, aws.ToString(operationID), domainName, err.Error()),
+		)
+		return
+	case opDetail.Status != types.OperationStatusSuccessful:
+		resp.Diagnostics.AddError(
+			"Transfer operation failed",
+			fmt.Sprintf("The %s operation for %s finished with status %s: %s", action, domainName, opDetail.Status, aws.ToString(opDetail.Message)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainTransferAcceptanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainTransferAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.OperationID.IsNull() {
+		return
+	}
+
+	opDetail, err := r.client.GetOperationDetail(ctx, &route53domains.GetOperationDetailInput{
+		OperationId: aws.String(data.OperationID.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading transfer status",
+			fmt.Sprintf("Could not read operation %s for %s: %s", data.OperationID.ValueString(), data.DomainName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.Status = tftypes.StringValue(string(opDetail.Status))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainTransferAcceptanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only transfer_timeout can change in place, and it has no effect after creation.
+	var data DomainTransferAcceptanceResourceModel
+	var state DomainTransferAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Status = state.Status
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainTransferAcceptanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainTransferAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Transfer acceptance will be removed from state only", map[string]interface{}{
+		"domain": data.DomainName.ValueString(),
+	})
+}
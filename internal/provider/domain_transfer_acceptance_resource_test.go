@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testTransferAcceptanceModel(reject bool) *DomainTransferAcceptanceResourceModel {
+	return &DomainTransferAcceptanceResourceModel{
+		ID:              tftypes.StringUnknown(),
+		DomainName:      tftypes.StringValue("example.com"),
+		Password:        tftypes.StringValue("s3cret"),
+		Reject:          tftypes.BoolValue(reject),
+		TransferTimeout: tftypes.Int64Value(60),
+		OperationID:     tftypes.StringUnknown(),
+		Status:          tftypes.StringUnknown(),
+	}
+}
+
+func TestDomainTransferAcceptanceCreate_accept(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	var acceptInput *route53domains.AcceptDomainTransferFromAnotherAwsAccountInput
+	polls := 0
+	client := mockOperationStatuses(&polls, types.OperationStatusInProgress, types.OperationStatusSuccessful)
+	client.AcceptDomainTransferFromAnotherAwsAccountFunc = func(ctx context.Context, params *route53domains.AcceptDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput, error) {
+		acceptInput = params
+		return &route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput{OperationId: aws.String("op-accept")}, nil
+	}
+	client.RejectDomainTransferFromAnotherAwsAccountFunc = func(ctx context.Context, params *route53domains.RejectDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.RejectDomainTransferFromAnotherAwsAccountOutput, error) {
+		t.Error("RejectDomainTransferFromAnotherAwsAccount should not be called when accepting")
+		return &route53domains.RejectDomainTransferFromAnotherAwsAccountOutput{}, nil
+	}
+	r := &DomainTransferAcceptanceResource{client: client}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testTransferAcceptanceModel(false))}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if acceptInput == nil || aws.ToString(acceptInput.Password) != "s3cret" {
+		t.Fatalf("Expected accept to be called with the password, got %+v", acceptInput)
+	}
+	if polls != 2 {
+		t.Errorf("Expected the operation to be polled to completion (2 polls), got %d", polls)
+	}
+
+	var state DomainTransferAcceptanceResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.OperationID.ValueString() != "op-accept" || state.Status.ValueString() != "SUCCESSFUL" {
+		t.Errorf("Unexpected state: operation_id=%s status=%s", state.OperationID.ValueString(), state.Status.ValueString())
+	}
+}
+
+func TestDomainTransferAcceptanceCreate_reject(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	ctx := context.Background()
+
+	rejected := false
+	polls := 0
+	client := mockOperationStatuses(&polls, types.OperationStatusSuccessful)
+	client.AcceptDomainTransferFromAnotherAwsAccountFunc = func(ctx context.Context, params *route53domains.AcceptDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput, error) {
+		t.Error("AcceptDomainTransferFromAnotherAwsAccount should not be called when rejecting")
+		return &route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput{}, nil
+	}
+	client.RejectDomainTransferFromAnotherAwsAccountFunc = func(ctx context.Context, params *route53domains.RejectDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.RejectDomainTransferFromAnotherAwsAccountOutput, error) {
+		rejected = aws.ToString(params.DomainName) == "example.com"
+		return &route53domains.RejectDomainTransferFromAnotherAwsAccountOutput{OperationId: aws.String("op-reject")}, nil
+	}
+	r := &DomainTransferAcceptanceResource{client: client}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testTransferAcceptanceModel(true))}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+	if !rejected {
+		t.Error("Expected RejectDomainTransferFromAnotherAwsAccount to be called")
+	}
+
+	var state DomainTransferAcceptanceResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.OperationID.ValueString() != "op-reject" {
+		t.Errorf("operation_id mismatch: got %s", state.OperationID.ValueString())
+	}
+}
+
+func TestDomainTransferAcceptanceCreate_failedOperation(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	polls := 0
+	client := mockOperationStatuses(&polls, types.OperationStatusFailed)
+	client.AcceptDomainTransferFromAnotherAwsAccountFunc = func(ctx context.Context, params *route53domains.AcceptDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput, error) {
+		return &route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput{OperationId: aws.String("op-accept")}, nil
+	}
+	r := &DomainTransferAcceptanceResource{client: client}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testTransferAcceptanceModel(false))}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error diagnostic for a failed operation")
+	}
+}
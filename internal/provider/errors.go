@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// isDomainNotFound reports whether err from GetDomainDetail means the domain
+// is not registered in this account. Route53 Domains signals this with an
+// InvalidInput error rather than a dedicated not-found type.
+func isDomainNotFound(err error) bool {
+	var invalidInput *types.InvalidInput
+	if !errors.As(err, &invalidInput) {
+		return false
+	}
+
+	message := strings.ToLower(aws.ToString(invalidInput.Message))
+	return strings.Contains(message, "not found") || strings.Contains(message, "not registered")
+}
+
+// invalidContactFieldPattern matches the request field an InvalidInput message
+// names, such as "AdminContact.Email" or "at 'registrantContact.phoneNumber'".
+var invalidContactFieldPattern = regexp.MustCompile(`(admin|registrant|tech|billing)contact\.([a-z0-9]+)`)
+
+// contactFieldAttributes maps ContactDetail fields to contact attributes.
+var contactFieldAttributes = map[string]string{
+	"firstname":        "first_name",
+	"lastname":         "last_name",
+	"organizationname": "organization_name",
+	"email":            "email",
+	"phonenumber":      "phone_number",
+	"addressline1":     "address_line_1",
+	"addressline2":     "address_line_2",
+	"city":             "city",
+	"state":            "state",
+	"zipcode":          "zip_code",
+	"countrycode":      "country_code",
+	"contacttype":      "contact_type",
+}
+
+// apiErrorPath returns the argument of data that an AWS error concerns, when
+// it can be told from the error code or the field an InvalidInput names.
+// Roles configured through a contact template map to the template name, and
+// roles configured through the shared contact to the contact block;
+// billing_contact never falls back to either.
+func apiErrorPath(err error, data DomainRegistrationResourceModel) (path.Path, bool) {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return path.Empty(), false
+	}
+
+	switch apiErr.ErrorCode() {
+	case "UnsupportedTLD":
+		return path.Root("domain_name"), true
+	case "InvalidInput":
+		message := strings.ToLower(apiErr.ErrorMessage())
+		if match := invalidContactFieldPattern.FindStringSubmatch(message); match != nil {
+			attribute, ok := contactFieldAttributes[match[2]]
+			if !ok {
+				return path.Empty(), false
+			}
+			roles := map[string]*ContactModel{
+				"admin":      data.AdminContact,
+				"registrant": data.RegistrantContact,
+				"tech":       data.TechContact,
+			}
+			templates := map[string]tftypes.String{
+				"admin":      data.AdminContactTemplate,
+				"registrant": data.RegistrantContactTemplate,
+				"tech":       data.TechContactTemplate,
+			}
+			block := match[1] + "_contact"
+			if match[1] != "billing" && roles[match[1]] == nil {
+				switch {
+				case !templates[match[1]].IsNull():
+					// The template is defined in the provider configuration
+					return path.Root(block + "_template"), true
+				case data.Contact != nil:
+					block = "contact"
+				}
+			}
+			return path.Root(block).AtName(attribute), true
+		}
+		if strings.Contains(message, "durationinyears") {
+			return path.Root("duration_years"), true
+		}
+	}
+	return path.Empty(), false
+}
+
+// addAPIError adds an error diagnostic for a failed AWS call, attached to the
+// offending argument when apiErrorPath can identify it.
+func addAPIError(diags *diag.Diagnostics, data DomainRegistrationResourceModel, summary, detail string, err error) {
+	if p, ok := apiErrorPath(err, data); ok {
+		diags.AddAttributeError(p, summary, detail)
+		return
+	}
+	diags.AddError(summary, detail)
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsDomainNotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "domain not found",
+			err:      &types.InvalidInput{Message: aws.String("Domain example.com not found in account 123456789012")},
+			expected: true,
+		},
+		{
+			name:     "wrapped not found",
+			err:      fmt.Errorf("operation error: %w", &types.InvalidInput{Message: aws.String("Domain not found")}),
+			expected: true,
+		},
+		{
+			name:     "other invalid input",
+			err:      &types.InvalidInput{Message: aws.String("The domain name contains invalid characters")},
+			expected: false,
+		},
+		{
+			name:     "throttling",
+			err:      &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"},
+			expected: false,
+		},
+		{
+			name:     "network error",
+			err:      errors.New("dial tcp: i/o timeout"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDomainNotFound(tt.err); got != tt.expected {
+				t.Errorf("isDomainNotFound() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAPIErrorPath(t *testing.T) {
+	roles := DomainRegistrationResourceModel{
+		AdminContact:      &ContactModel{},
+		RegistrantContact: &ContactModel{},
+		TechContact:       &ContactModel{},
+	}
+	shared := DomainRegistrationResourceModel{
+		Contact:      &ContactModel{},
+		AdminContact: &ContactModel{},
+	}
+	templated := DomainRegistrationResourceModel{
+		Contact:             &ContactModel{},
+		TechContactTemplate: tftypes.StringValue("ops"),
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		data     DomainRegistrationResourceModel
+		wantPath path.Path
+		wantOK   bool
+	}{
+		{
+			name:     "admin email",
+			err:      &types.InvalidInput{Message: aws.String("Errors: [AdminContact.Email is invalid]")},
+			data:     roles,
+			wantPath: path.Root("admin_contact").AtName("email"),
+			wantOK:   true,
+		},
+		{
+			name:     "validation exception style",
+			err:      fmt.Errorf("operation error: %w", &types.InvalidInput{Message: aws.String("Value at 'registrantContact.phoneNumber' failed to satisfy constraint")}),
+			data:     roles,
+			wantPath: path.Root("registrant_contact").AtName("phone_number"),
+			wantOK:   true,
+		},
+		{
+			name:     "role from shared contact",
+			err:      &types.InvalidInput{Message: aws.String("Invalid TechContact.ZipCode")},
+			data:     shared,
+			wantPath: path.Root("contact").AtName("zip_code"),
+			wantOK:   true,
+		},
+		{
+			name:     "role from a contact template",
+			err:      &types.InvalidInput{Message: aws.String("Invalid TechContact.ZipCode")},
+			data:     templated,
+			wantPath: path.Root("tech_contact_template"),
+			wantOK:   true,
+		},
+		{
+			name:     "billing never uses the shared contact",
+			err:      &types.InvalidInput{Message: aws.String("Invalid BillingContact.Email")},
+			data:     shared,
+			wantPath: path.Root("billing_contact").AtName("email"),
+			wantOK:   true,
+		},
+		{
+			name:     "duration",
+			err:      &types.InvalidInput{Message: aws.String("DurationInYears must be between 1 and 10")},
+			data:     roles,
+			wantPath: path.Root("duration_years"),
+			wantOK:   true,
+		},
+		{
+			name:     "unsupported tld",
+			err:      &types.UnsupportedTLD{Message: aws.String("TLD not supported")},
+			data:     roles,
+			wantPath: path.Root("domain_name"),
+			wantOK:   true,
+		},
+		{
+			name: "unknown field",
+			err:  &types.InvalidInput{Message: aws.String("AdminContact.ExtraParams is invalid")},
+			data: roles,
+		},
+		{
+			name: "other api error",
+			err:  &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"},
+			data: roles,
+		},
+		{
+			name: "not an api error",
+			err:  errors.New("dial tcp: i/o timeout"),
+			data: roles,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := apiErrorPath(tt.err, tt.data)
+			if ok != tt.wantOK {
+				t.Fatalf("apiErrorPath() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(tt.wantPath) {
+				t.Errorf("apiErrorPath() = %s, want %s", got, tt.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,436 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeRoute53Domains is an in-memory Route53 Domains backend speaking the
+// JSON 1.1 protocol the SDK uses, so the full domain lifecycle can run against
+// it through route53domains_endpoint without registering real domains. Every
+// operation it starts succeeds immediately. Calls records the operations
+// received, in order.
+type fakeRoute53Domains struct {
+	mu         sync.Mutex
+	domains    map[string]*fakeDomain
+	operations map[string]fakeOperation
+	Calls      []string
+
+	server *httptest.Server
+}
+
+type fakeDomain struct {
+	detail route53domains.GetDomainDetailOutput
+}
+
+type fakeOperation struct {
+	id         string
+	domainName string
+	opType     types.OperationType
+}
+
+// newFakeRoute53Domains starts a fake backend that is shut down when the test
+// ends.
+func newFakeRoute53Domains(t *testing.T) *fakeRoute53Domains {
+	t.Helper()
+	f := &fakeRoute53Domains{
+		domains:    map[string]*fakeDomain{},
+		operations: map[string]fakeOperation{},
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// URL is the endpoint to set as route53domains_endpoint.
+func (f *fakeRoute53Domains) URL() string {
+	return f.server.URL
+}
+
+// Domain returns the stored detail of a registered domain.
+func (f *fakeRoute53Domains) Domain(name string) (route53domains.GetDomainDetailOutput, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	d, ok := f.domains[name]
+	if !ok {
+		return route53domains.GetDomainDetailOutput{}, false
+	}
+	return d.detail, true
+}
+
+// Called reports whether the operation was received.
+func (f *fakeRoute53Domains) Called(operation string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range f.Calls {
+		if c == operation {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeRoute53Domains) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	operation := strings.TrimPrefix(req.Header.Get("X-Amz-Target"), "Route53Domains_v20140515.")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Calls = append(f.Calls, operation)
+
+	handler, ok := fakeHandlers[operation]
+	if !ok {
+		writeFakeError(w, "UnknownOperationException", fmt.Sprintf("%s is not supported by the fake backend", operation))
+		return
+	}
+	output, err := handler(f, json.NewDecoder(req.Body))
+	if err != nil {
+		writeFakeError(w, "InvalidInput", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+func writeFakeError(w http.ResponseWriter, code, message string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"__type": code, "message": message})
+}
+
+// fakeHandlers decode a request and return the response body. Input structs
+// are decoded straight from the request, as their field names match the wire
+// names; responses are built by hand since timestamps are epoch seconds.
+var fakeHandlers = map[string]func(f *fakeRoute53Domains, body *json.Decoder) (any, error){
+	"CheckDomainAvailability": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		var in route53domains.CheckDomainAvailabilityInput
+		if err := body.Decode(&in); err != nil {
+			return nil, err
+		}
+		availability := types.DomainAvailabilityAvailable
+		if _, ok := f.domains[aws.ToString(in.DomainName)]; ok {
+			availability = types.DomainAvailabilityUnavailable
+		}
+		return map[string]any{"Availability": availability}, nil
+	},
+	"RegisterDomain": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		var in route53domains.RegisterDomainInput
+		if err := body.Decode(&in); err != nil {
+			return nil, err
+		}
+		name := aws.ToString(in.DomainName)
+		if _, ok := f.domains[name]; ok {
+			return nil, fmt.Errorf("domain %s is already registered", name)
+		}
+		now := time.Now().UTC().Truncate(time.Second)
+		f.domains[name] = &fakeDomain{detail: route53domains.GetDomainDetailOutput{
+			DomainName:        in.DomainName,
+			AdminContact:      in.AdminContact,
+			RegistrantContact: in.RegistrantContact,
+			TechContact:       in.TechContact,
+			BillingContact:    in.BillingContact,
+			AdminPrivacy:      aws.Bool(aws.ToBool(in.PrivacyProtectAdminContact)),
+			RegistrantPrivacy: aws.Bool(aws.ToBool(in.PrivacyProtectRegistrantContact)),
+			TechPrivacy:       aws.Bool(aws.ToBool(in.PrivacyProtectTechContact)),
+			BillingPrivacy:    in.PrivacyProtectBillingContact,
+			AutoRenew:         aws.Bool(aws.ToBool(in.AutoRenew)),
+			CreationDate:      aws.Time(now),
+			ExpirationDate:    aws.Time(now.AddDate(int(aws.ToInt32(in.DurationInYears)), 0, 0)),
+			Nameservers: []types.Nameserver{
+				{Name: aws.String("ns-1.awsdns-fake.com")},
+				{Name: aws.String("ns-2.awsdns-fake.net")},
+			},
+			StatusList:    []string{"clientTransferProhibited"},
+			RegistrarName: aws.String("Amazon Registrar, Inc."),
+		}}
+		return f.startOperation(name, types.OperationTypeRegisterDomain), nil
+	},
+	"GetOperationDetail": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		var in route53domains.GetOperationDetailInput
+		if err := body.Decode(&in); err != nil {
+			return nil, err
+		}
+		op, ok := f.operations[aws.ToString(in.OperationId)]
+		if !ok {
+			return nil, fmt.Errorf("operation %s not found", aws.ToString(in.OperationId))
+		}
+		return map[string]any{
+			"OperationId": in.OperationId,
+			"DomainName":  op.domainName,
+			"Type":        op.opType,
+			"Status":      types.OperationStatusSuccessful,
+		}, nil
+	},
+	"ListOperations": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		var in route53domains.ListOperationsInput
+		if err := body.Decode(&in); err != nil {
+			return nil, err
+		}
+		// Every fake operation has already succeeded
+		operations := []map[string]any{}
+		if len(in.Status) == 0 || slices.Contains(in.Status, types.OperationStatusSuccessful) {
+			for _, op := range f.operations {
+				if len(in.Type) == 0 || slices.Contains(in.Type, op.opType) {
+					operations = append(operations, map[string]any{
+						"OperationId": op.id,
+						"DomainName":  op.domainName,
+						"Type":        op.opType,
+						"Status":      types.OperationStatusSuccessful,
+					})
+				}
+			}
+		}
+		return map[string]any{"Operations": operations}, nil
+	},
+	"GetDomainDetail": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		d, err := f.decodeDomain(body)
+		if err != nil {
+			return nil, err
+		}
+		detail := d.detail
+		return map[string]any{
+			"DomainName":        detail.DomainName,
+			"AdminContact":      detail.AdminContact,
+			"RegistrantContact": detail.RegistrantContact,
+			"TechContact":       detail.TechContact,
+			"BillingContact":    detail.BillingContact,
+			"AdminPrivacy":      detail.AdminPrivacy,
+			"RegistrantPrivacy": detail.RegistrantPrivacy,
+			"TechPrivacy":       detail.TechPrivacy,
+			"BillingPrivacy":    detail.BillingPrivacy,
+			"AutoRenew":         detail.AutoRenew,
+			"CreationDate":      detail.CreationDate.Unix(),
+			"ExpirationDate":    detail.ExpirationDate.Unix(),
+			"Nameservers":       detail.Nameservers,
+			"StatusList":        detail.StatusList,
+			"RegistrarName":     detail.RegistrarName,
+		}, nil
+	},
+	"GetContactReachabilityStatus": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		d, err := f.decodeDomain(body)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"domainName": d.detail.DomainName, "status": types.ReachabilityStatusDone}, nil
+	},
+	"UpdateDomainContact": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		var in route53domains.UpdateDomainContactInput
+		if err := body.Decode(&in); err != nil {
+			return nil, err
+		}
+		d, err := f.domain(in.DomainName)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range []struct{ from, to **types.ContactDetail }{
+			{&in.AdminContact, &d.detail.AdminContact},
+			{&in.RegistrantContact, &d.detail.RegistrantContact},
+			{&in.TechContact, &d.detail.TechContact},
+			{&in.BillingContact, &d.detail.BillingContact},
+		} {
+			if *c.from != nil {
+				*c.to = *c.from
+			}
+		}
+		return f.startOperation(aws.ToString(in.DomainName), types.OperationTypeUpdateDomainContact), nil
+	},
+	"UpdateDomainContactPrivacy": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		var in route53domains.UpdateDomainContactPrivacyInput
+		if err := body.Decode(&in); err != nil {
+			return nil, err
+		}
+		d, err := f.domain(in.DomainName)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range []struct{ from, to **bool }{
+			{&in.AdminPrivacy, &d.detail.AdminPrivacy},
+			{&in.RegistrantPrivacy, &d.detail.RegistrantPrivacy},
+			{&in.TechPrivacy, &d.detail.TechPrivacy},
+			{&in.BillingPrivacy, &d.detail.BillingPrivacy},
+		} {
+			if *p.from != nil {
+				*p.to = *p.from
+			}
+		}
+		return f.startOperation(aws.ToString(in.DomainName), types.OperationTypeChangePrivacyProtection), nil
+	},
+	"UpdateDomainNameservers": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		var in route53domains.UpdateDomainNameserversInput
+		if err := body.Decode(&in); err != nil {
+			return nil, err
+		}
+		d, err := f.domain(in.DomainName)
+		if err != nil {
+			return nil, err
+		}
+		d.detail.Nameservers = in.Nameservers
+		return f.startOperation(aws.ToString(in.DomainName), types.OperationTypeUpdateNameserver), nil
+	},
+	"EnableDomainAutoRenew": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		d, err := f.decodeDomain(body)
+		if err != nil {
+			return nil, err
+		}
+		d.detail.AutoRenew = aws.Bool(true)
+		return map[string]any{}, nil
+	},
+	"DisableDomainAutoRenew": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		d, err := f.decodeDomain(body)
+		if err != nil {
+			return nil, err
+		}
+		d.detail.AutoRenew = aws.Bool(false)
+		return map[string]any{}, nil
+	},
+	"DisableDomainTransferLock": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		d, err := f.decodeDomain(body)
+		if err != nil {
+			return nil, err
+		}
+		d.detail.StatusList = slices.DeleteFunc(d.detail.StatusList, func(s string) bool { return s == "clientTransferProhibited" })
+		return f.startOperation(aws.ToString(d.detail.DomainName), types.OperationTypeDomainLock), nil
+	},
+	"DeleteDomain": func(f *fakeRoute53Domains, body *json.Decoder) (any, error) {
+		d, err := f.decodeDomain(body)
+		if err != nil {
+			return nil, err
+		}
+		name := aws.ToString(d.detail.DomainName)
+		delete(f.domains, name)
+		return f.startOperation(name, types.OperationTypeDeleteDomain), nil
+	},
+}
+
+// decodeDomain decodes a request naming a domain and returns that domain.
+func (f *fakeRoute53Domains) decodeDomain(body *json.Decoder) (*fakeDomain, error) {
+	var in struct{ DomainName *string }
+	if err := body.Decode(&in); err != nil {
+		return nil, err
+	}
+	return f.domain(in.DomainName)
+}
+
+func (f *fakeRoute53Domains) domain(name *string) (*fakeDomain, error) {
+	d, ok := f.domains[aws.ToString(name)]
+	if !ok {
+		return nil, fmt.Errorf("domain %s not found", aws.ToString(name))
+	}
+	return d, nil
+}
+
+func (f *fakeRoute53Domains) startOperation(domainName string, opType types.OperationType) map[string]any {
+	id := fmt.Sprintf("op-%d", len(f.operations)+1)
+	f.operations[id] = fakeOperation{id: id, domainName: domainName, opType: opType}
+	return map[string]any{"OperationId": id}
+}
+
+// client returns a real Route53 Domains client routed to the fake backend.
+func (f *fakeRoute53Domains) client() *route53domains.Client {
+	return route53domains.New(route53domains.Options{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(f.URL()),
+		Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "test", SecretAccessKey: "test"}, nil
+		}),
+	})
+}
+
+// TestFakeRoute53Domains_lifecycle runs Create, Read, Update and Delete
+// through the SDK's wire protocol against the fake backend.
+func TestFakeRoute53Domains_lifecycle(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	fake := newFakeRoute53Domains(t)
+	r := &DomainRegistrationResource{client: fake.client(), route53Client: &MockRoute53Client{}}
+	ctx := context.Background()
+
+	plan := testDomainModel("example.com")
+	plan.ManageHostedZone = tftypes.BoolValue(false)
+	plan.AllowDelete = tftypes.BoolValue(true)
+	plan.UnlockBeforeDelete = tftypes.BoolValue(true)
+
+	createResp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: newResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", createResp.Diagnostics)
+	}
+	if _, ok := fake.Domain("example.com"); !ok {
+		t.Fatal("Expected example.com to be registered")
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	var state DomainRegistrationResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "example.com" {
+		t.Errorf("Expected id example.com, got %s", state.ID)
+	}
+	if state.ReachabilityStatus.ValueString() != string(types.ReachabilityStatusDone) {
+		t.Errorf("Expected reachability_status DONE, got %s", state.ReachabilityStatus)
+	}
+
+	planned := state
+	planned.AutoRenew = tftypes.BoolValue(true)
+	planned.TechContact = testContact("new-tech@example.com")
+	planned.Nameservers = []NameserverModel{
+		{Name: stringValue("ns1.example.net")},
+		{Name: stringValue("ns2.example.net")},
+	}
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: newResourcePlan(t, r, &planned), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", updateResp.Diagnostics)
+	}
+	detail, _ := fake.Domain("example.com")
+	if !aws.ToBool(detail.AutoRenew) {
+		t.Error("Expected auto-renew to be enabled")
+	}
+	if got := aws.ToString(detail.TechContact.Email); got != "new-tech@example.com" {
+		t.Errorf("Expected tech contact email new-tech@example.com, got %s", got)
+	}
+	if got := aws.ToString(detail.AdminContact.Email); got != "admin@example.com" {
+		t.Errorf("Expected admin contact to be unchanged, got %s", got)
+	}
+	if len(detail.Nameservers) != 2 || aws.ToString(detail.Nameservers[0].Name) != "ns1.example.net" {
+		t.Errorf("Expected nameservers to be updated, got %v", detail.Nameservers)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete: %v", deleteResp.Diagnostics)
+	}
+	if _, ok := fake.Domain("example.com"); ok {
+		t.Error("Expected example.com to be deleted")
+	}
+	if !fake.Called("DisableDomainTransferLock") {
+		t.Error("Expected the transfer lock to be removed before deletion")
+	}
+
+	goneResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() {
+		t.Fatalf("Read after delete: %v", goneResp.Diagnostics)
+	}
+	if !goneResp.State.Raw.IsNull() {
+		t.Error("Expected the deleted domain to be removed from state")
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OperationAuthorizationResource{}
+
+type OperationAuthorizationResource struct {
+	client Route53DomainsAPI
+}
+
+type OperationAuthorizationResourceModel struct {
+	ID          tftypes.String `tfsdk:"id"`
+	OperationID tftypes.String `tfsdk:"operation_id"`
+	Triggers    tftypes.Map    `tfsdk:"triggers"`
+}
+
+func NewOperationAuthorizationResource() resource.Resource {
+	return &OperationAuthorizationResource{}
+}
+
+func (r *OperationAuthorizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_authorization"
+}
+
+func (r *OperationAuthorizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resends the authorization email for a pending operation, such as a transfer whose authorization email was lost. The email is sent once on creation; change operation_id or triggers to send it again. Destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The operation ID (used as the resource ID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the operation to resend the authorization email for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: tftypes.StringType,
+				Description: "Arbitrary values that resend the authorization email when changed.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OperationAuthorizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.DomainsClient
+}
+
+func (r *OperationAuthorizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OperationAuthorizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operationID := data.OperationID.ValueString()
+
+	tflog.Info(ctx, "Resending operation authorization", map[string]interface{}{
+		"operation_id": operationID,
+	})
+
+	// Fire-and-forget: the API only sends the email, so there is nothing to poll
+	_, err := r.client.ResendOperationAuthorization(ctx, &route53domains.ResendOperationAuthorizationInput{
+		OperationId: aws.String(operationID),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error resending operation authorization",
+			fmt.Sprintf("Could not resend the authorization email for operation %s: %s", operationID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = tftypes.StringValue(operationID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationAuthorizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Nothing to refresh: resending the email leaves no remote object behind.
+	var data OperationAuthorizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationAuthorizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument forces replacement, so there is nothing to update in place.
+	var data OperationAuthorizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OperationAuthorizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OperationAuthorizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Operation authorization will be removed from state only", map[string]interface{}{
+		"operation_id": data.OperationID.ValueString(),
+	})
+}
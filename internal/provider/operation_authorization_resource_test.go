@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testOperationAuthorizationModel() *OperationAuthorizationResourceModel {
+	return &OperationAuthorizationResourceModel{
+		ID:          tftypes.StringUnknown(),
+		OperationID: tftypes.StringValue("op-transfer"),
+		Triggers:    tftypes.MapNull(tftypes.StringType),
+	}
+}
+
+func TestOperationAuthorizationCreate(t *testing.T) {
+	ctx := context.Background()
+
+	var input *route53domains.ResendOperationAuthorizationInput
+	r := &OperationAuthorizationResource{
+		client: &MockRoute53DomainsClient{
+			ResendOperationAuthorizationFunc: func(ctx context.Context, params *route53domains.ResendOperationAuthorizationInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendOperationAuthorizationOutput, error) {
+				input = params
+				return &route53domains.ResendOperationAuthorizationOutput{}, nil
+			},
+		},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testOperationAuthorizationModel())}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if input == nil || aws.ToString(input.OperationId) != "op-transfer" {
+		t.Fatalf("Expected ResendOperationAuthorization for op-transfer, got %+v", input)
+	}
+
+	var state OperationAuthorizationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "op-transfer" {
+		t.Errorf("Expected id op-transfer, got %s", state.ID)
+	}
+}
+
+func TestOperationAuthorizationCreate_error(t *testing.T) {
+	r := &OperationAuthorizationResource{
+		client: &MockRoute53DomainsClient{
+			ResendOperationAuthorizationFunc: func(ctx context.Context, params *route53domains.ResendOperationAuthorizationInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendOperationAuthorizationOutput, error) {
+				return nil, errors.New("operation not found")
+			},
+		},
+	}
+
+	req := resource.CreateRequest{Plan: newResourcePlan(t, r, testOperationAuthorizationModel())}
+	resp := &resource.CreateResponse{State: newResourceState(t, r, nil)}
+	r.Create(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error diagnostic")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Error resending operation authorization" {
+		t.Errorf("Unexpected diagnostic summary: %s", summary)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected no state after a failed resend")
+	}
+}
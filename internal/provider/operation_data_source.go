@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OperationDataSource{}
+
+type OperationDataSource struct {
+	client Route53DomainsAPI
+}
+
+type OperationDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	OperationID     types.String `tfsdk:"operation_id"`
+	Status          types.String `tfsdk:"status"`
+	Type            types.String `tfsdk:"type"`
+	DomainName      types.String `tfsdk:"domain_name"`
+	Message         types.String `tfsdk:"message"`
+	SubmittedDate   types.String `tfsdk:"submitted_date"`
+	LastUpdatedDate types.String `tfsdk:"last_updated_date"`
+}
+
+func NewOperationDataSource() datasource.DataSource {
+	return &OperationDataSource{}
+}
+
+func (d *OperationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation"
+}
+
+func (d *OperationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the current status of a Route53 Domains operation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The operation ID.",
+			},
+			"operation_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the operation to look up.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The operation status: SUBMITTED, IN_PROGRESS, ERROR, SUCCESSFUL, or FAILED.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of operation (e.g., REGISTER_DOMAIN, UPDATE_NAMESERVER).",
+			},
+			"domain_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The domain the operation applies to.",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Detailed information on the operation status, if any.",
+			},
+			"submitted_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date the operation was submitted (RFC3339).",
+			},
+			"last_updated_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date the operation was last updated (RFC3339).",
+			},
+		},
+	}
+}
+
+func (d *OperationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.DomainsClient
+}
+
+func (d *OperationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OperationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operationID := data.OperationID.ValueString()
+
+	output, err := d.client.GetOperationDetail(ctx, &route53domains.GetOperationDetailInput{
+		OperationId: aws.String(operationID),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading operation",
+			fmt.Sprintf("Could not read operation %s: %s", operationID, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(operationID)
+	data.Status = types.StringValue(string(output.Status))
+	data.Type = types.StringValue(string(output.Type))
+	data.DomainName = types.StringValue(aws.ToString(output.DomainName))
+	data.Message = types.StringValue(aws.ToString(output.Message))
+	data.SubmittedDate = types.StringNull()
+	if output.SubmittedDate != nil {
+		data.SubmittedDate = types.StringValue(formatTimestamp(*output.SubmittedDate))
+	}
+	data.LastUpdatedDate = types.StringNull()
+	if output.LastUpdatedDate != nil {
+		data.LastUpdatedDate = types.StringValue(formatTimestamp(*output.LastUpdatedDate))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
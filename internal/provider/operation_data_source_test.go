@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOperationDataSourceMetadata(t *testing.T) {
+	d := NewOperationDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "awsdomains"}, resp)
+
+	if resp.TypeName != "awsdomains_operation" {
+		t.Errorf("Expected TypeName 'awsdomains_operation', got '%s'", resp.TypeName)
+	}
+}
+
+func TestOperationDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	submitted := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	updated := submitted.Add(10 * time.Minute)
+
+	var requestedID string
+	d := &OperationDataSource{
+		client: &MockRoute53DomainsClient{
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				requestedID = aws.ToString(params.OperationId)
+				return &route53domains.GetOperationDetailOutput{
+					OperationId:     params.OperationId,
+					Status:          types.OperationStatusInProgress,
+					Type:            types.OperationTypeRegisterDomain,
+					DomainName:      aws.String("example.com"),
+					Message:         aws.String("Waiting for registry"),
+					SubmittedDate:   aws.Time(submitted),
+					LastUpdatedDate: aws.Time(updated),
+				}, nil
+			},
+		},
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &OperationDataSourceModel{
+		OperationID: tftypes.StringValue("op-123"),
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	if requestedID != "op-123" {
+		t.Errorf("Expected operation ID 'op-123' to be requested, got '%s'", requestedID)
+	}
+
+	var state OperationDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Could not read state: %v", resp.Diagnostics)
+	}
+
+	checks := map[string][2]string{
+		"id":                {state.ID.ValueString(), "op-123"},
+		"status":            {state.Status.ValueString(), "IN_PROGRESS"},
+		"type":              {state.Type.ValueString(), "REGISTER_DOMAIN"},
+		"domain_name":       {state.DomainName.ValueString(), "example.com"},
+		"message":           {state.Message.ValueString(), "Waiting for registry"},
+		"submitted_date":    {state.SubmittedDate.ValueString(), "2026-01-02T03:04:05Z"},
+		"last_updated_date": {state.LastUpdatedDate.ValueString(), "2026-01-02T03:14:05Z"},
+	}
+	for attr, c := range checks {
+		if c[0] != c[1] {
+			t.Errorf("%s mismatch: got %s, want %s", attr, c[0], c[1])
+		}
+	}
+}
+
+func TestOperationDataSourceReadError(t *testing.T) {
+	d := &OperationDataSource{
+		client: &MockRoute53DomainsClient{
+			GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+				return nil, errors.New("InvalidInput: operation not found")
+			},
+		},
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &OperationDataSourceModel{
+		OperationID: tftypes.StringValue("missing"),
+	})
+	d.Read(context.Background(), req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error diagnostic")
+	}
+}
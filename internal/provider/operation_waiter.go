@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// operationPollInterval is how often GetOperationDetail is polled while
+// waiting for an operation. Each wait adds up to a fifth of it as jitter so
+// many concurrent applies do not poll in lockstep. Tests shorten it.
+var operationPollInterval = 10 * time.Second
+
+// operationMaxAttempts caps how many times GetOperationDetail is polled in one
+// wait, so the wait ends even if the deadline never fires. Zero derives the
+// cap from the timeout via maxOperationAttempts. Tests set it directly.
+var operationMaxAttempts = 0
+
+// operationWaiter returns the Waiter polling GetOperationDetail until the
+// operation reaches a terminal status (SUCCESSFUL, FAILED or ERROR).
+func operationWaiter(client Route53DomainsAPI, operationID string, timeout time.Duration) Waiter[*route53domains.GetOperationDetailOutput] {
+	attempt := 0
+	return Waiter[*route53domains.GetOperationDetailOutput]{
+		InitialDelay: operationPollInterval,
+		Jitter:       0.2,
+		Timeout:      timeout,
+		MaxAttempts:  maxOperationAttempts(timeout),
+		Poll: func(ctx context.Context) (*route53domains.GetOperationDetailOutput, error) {
+			attempt++
+			opDetail, err := client.GetOperationDetail(ctx, &route53domains.GetOperationDetailInput{
+				OperationId: aws.String(operationID),
+			})
+			if err != nil {
+				return nil, err
+			}
+			fields := map[string]interface{}{
+				"operation_id": operationID,
+				"domain":       aws.ToString(opDetail.DomainName),
+				"status":       opDetail.Status,
+				"attempt":      attempt,
+			}
+			if opDetail.SubmittedDate != nil {
+				fields["submitted_date"] = formatTimestamp(*opDetail.SubmittedDate)
+				fields["elapsed"] = operationElapsed(opDetail, time.Now()).String()
+			}
+			if opDetail.LastUpdatedDate != nil {
+				fields["last_updated_date"] = formatTimestamp(*opDetail.LastUpdatedDate)
+			}
+			tflog.Debug(ctx, "Operation status", fields)
+			return opDetail, nil
+		},
+		Terminal: operationTerminal,
+	}
+}
+
+// operationTerminal reports whether an operation has finished, successfully
+// or not.
+func operationTerminal(opDetail *route53domains.GetOperationDetailOutput) bool {
+	switch opDetail.Status {
+	case types.OperationStatusSuccessful, types.OperationStatusFailed, types.OperationStatusError:
+		return true
+	}
+	return false
+}
+
+// waitForOperation polls GetOperationDetail until the operation reaches a
+// terminal status, the timeout expires, the attempt cap is reached, or ctx is
+// cancelled. The most recent operation detail is returned alongside
+// errWaitTimeout or ctx.Err() so callers can report progress.
+func waitForOperation(ctx context.Context, client Route53DomainsAPI, operationID string, timeout time.Duration) (*route53domains.GetOperationDetailOutput, error) {
+	opDetail, err := operationWaiter(client, operationID, timeout).Wait(ctx)
+	switch {
+	case errors.Is(err, errWaitAttemptsExhausted):
+		tflog.Warn(ctx, "Giving up waiting for operation", map[string]interface{}{
+			"operation_id": operationID,
+			"attempts":     maxOperationAttempts(timeout),
+		})
+	case err != nil && ctx.Err() == nil && !errors.Is(err, errWaitTimeout):
+		return nil, fmt.Errorf("failed to get operation detail: %w", err)
+	}
+	return opDetail, err
+}
+
+// operationElapsed returns how long the operation has been running at now,
+// rounded to the second. It is zero when the detail has no submitted date.
+func operationElapsed(opDetail *route53domains.GetOperationDetailOutput, now time.Time) time.Duration {
+	if opDetail == nil || opDetail.SubmittedDate == nil {
+		return 0
+	}
+	return now.Sub(*opDetail.SubmittedDate).Round(time.Second)
+}
+
+// operationAge describes when the operation was submitted and last updated,
+// for diagnostics: " It was submitted at 2026-01-02T03:04:05Z (42m0s ago) and
+// last updated at 2026-01-02T03:14:05Z." It is empty when the detail has no
+// submitted date.
+func operationAge(opDetail *route53domains.GetOperationDetailOutput, now time.Time) string {
+	if opDetail == nil || opDetail.SubmittedDate == nil {
+		return ""
+	}
+	age := fmt.Sprintf(" It was submitted at %s (%s ago)", formatTimestamp(*opDetail.SubmittedDate), operationElapsed(opDetail, now))
+	if opDetail.LastUpdatedDate != nil {
+		age += " and last updated at " + formatTimestamp(*opDetail.LastUpdatedDate)
+	}
+	return age + "."
+}
+
+// maxOperationAttempts returns the attempt cap for a wait of timeout: twice
+// the polls the timeout allows, so it only ends a wait whose deadline misfired.
+func maxOperationAttempts(timeout time.Duration) int {
+	if operationMaxAttempts > 0 {
+		return operationMaxAttempts
+	}
+	return 2*int(timeout/operationPollInterval) + 2
+}
+
+// waitLimit describes the limit a wait that returned errWaitTimeout stopped
+// at, for diagnostics: "15m0s", or "182 status checks" when the attempt cap
+// was reached first.
+func waitLimit(err error, timeout time.Duration) string {
+	if errors.Is(err, errWaitAttemptsExhausted) {
+		return fmt.Sprintf("%d status checks", maxOperationAttempts(timeout))
+	}
+	return timeout.String()
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+)
+
+// mockOperationStatuses returns a client whose GetOperationDetail returns the
+// given statuses in order, repeating the last one.
+func mockOperationStatuses(calls *int, statuses ...types.OperationStatus) *MockRoute53DomainsClient {
+	return &MockRoute53DomainsClient{
+		GetOperationDetailFunc: func(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error) {
+			i := *calls
+			if i >= len(statuses) {
+				i = len(statuses) - 1
+			}
+			*calls++
+			return &route53domains.GetOperationDetailOutput{OperationId: params.OperationId, Status: statuses[i]}, nil
+		},
+	}
+}
+
+func TestWaitForOperation_successful(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	calls := 0
+	client := mockOperationStatuses(&calls, types.OperationStatusSubmitted, types.OperationStatusInProgress, types.OperationStatusSuccessful)
+
+	opDetail, err := waitForOperation(context.Background(), client, "op-1", time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if opDetail.Status != types.OperationStatusSuccessful {
+		t.Errorf("Expected SUCCESSFUL, got %s", opDetail.Status)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 polls, got %d", calls)
+	}
+}
+
+func TestWaitForOperation_timeout(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+
+	calls := 0
+	client := mockOperationStatuses(&calls, types.OperationStatusInProgress)
+
+	opDetail, err := waitForOperation(context.Background(), client, "op-1", 20*time.Millisecond)
+	if !errors.Is(err, errWaitTimeout) {
+		t.Fatalf("Expected errWaitTimeout, got %v", err)
+	}
+	if opDetail == nil || opDetail.Status != types.OperationStatusInProgress {
+		t.Errorf("Expected last IN_PROGRESS detail, got %v", opDetail)
+	}
+}
+
+func TestWaitForOperation_contextCancelled(t *testing.T) {
+	defer setOperationPollInterval(time.Hour)()
+
+	calls := 0
+	client := mockOperationStatuses(&calls, types.OperationStatusInProgress)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := waitForOperation(ctx, client, "op-1", time.Hour)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("waitForOperation did not return promptly (took %s)", elapsed)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 poll before cancellation, got %d", calls)
+	}
+}
+
+func TestWaitForOperation_attemptCap(t *testing.T) {
+	defer setOperationPollInterval(time.Millisecond)()
+	previous := operationMaxAttempts
+	operationMaxAttempts = 3
+	defer func() { operationMaxAttempts = previous }()
+
+	calls := 0
+	client := mockOperationStatuses(&calls, types.OperationStatusInProgress)
+
+	opDetail, err := waitForOperation(context.Background(), client, "op-1", time.Hour)
+	if !errors.Is(err, errWaitAttemptsExhausted) {
+		t.Fatalf("Expected errWaitAttemptsExhausted, got %v", err)
+	}
+	if !errors.Is(err, errWaitTimeout) {
+		t.Error("Expected the exhausted error to also match errWaitTimeout")
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 polls, got %d", calls)
+	}
+	if opDetail == nil || opDetail.Status != types.OperationStatusInProgress {
+		t.Errorf("Expected last IN_PROGRESS detail, got %v", opDetail)
+	}
+	if got := waitLimit(err, time.Hour); got != "3 status checks" {
+		t.Errorf("Unexpected wait limit %q", got)
+	}
+	if got := waitLimit(errWaitTimeout, time.Hour); got != "1h0m0s" {
+		t.Errorf("Unexpected wait limit %q", got)
+	}
+}
+
+func TestMaxOperationAttempts(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Second)()
+
+	if got := maxOperationAttempts(15 * time.Minute); got != 182 {
+		t.Errorf("Expected 182 attempts for 15m, got %d", got)
+	}
+	if got := maxOperationAttempts(0); got != 2 {
+		t.Errorf("Expected 2 attempts for no timeout, got %d", got)
+	}
+}
+
+func TestOperationTerminal(t *testing.T) {
+	for _, status := range types.OperationStatus("").Values() {
+		want := status == types.OperationStatusSuccessful || status == types.OperationStatusFailed || status == types.OperationStatusError
+		if got := operationTerminal(&route53domains.GetOperationDetailOutput{Status: status}); got != want {
+			t.Errorf("operationTerminal(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestOperationWaiterJitter(t *testing.T) {
+	defer setOperationPollInterval(10 * time.Second)()
+
+	w := operationWaiter(&MockRoute53DomainsClient{}, "op-1", time.Hour)
+	for range 100 {
+		if d := w.jittered(w.delay(5)); d < 10*time.Second || d >= 12*time.Second {
+			t.Fatalf("Jittered interval %s outside [10s, 12s)", d)
+		}
+	}
+}
+
+func TestOperationAge(t *testing.T) {
+	submitted := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := submitted.Add(42*time.Minute + 400*time.Millisecond)
+
+	tests := []struct {
+		name     string
+		opDetail *route53domains.GetOperationDetailOutput
+		want     string
+	}{
+		{"no detail", nil, ""},
+		{"no submitted date", &route53domains.GetOperationDetailOutput{}, ""},
+		{"submitted", &route53domains.GetOperationDetailOutput{SubmittedDate: aws.Time(submitted)}, " It was submitted at 2026-01-02T03:04:05Z (42m0s ago)."},
+		{"updated", &route53domains.GetOperationDetailOutput{SubmittedDate: aws.Time(submitted), LastUpdatedDate: aws.Time(submitted.Add(10 * time.Minute))}, " It was submitted at 2026-01-02T03:04:05Z (42m0s ago) and last updated at 2026-01-02T03:14:05Z."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := operationAge(tt.opDetail, now); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
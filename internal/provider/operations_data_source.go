@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OperationsDataSource{}
+
+type OperationsDataSource struct {
+	client Route53DomainsAPI
+}
+
+type OperationsDataSourceModel struct {
+	ID             types.String            `tfsdk:"id"`
+	SubmittedSince types.String            `tfsdk:"submitted_since"`
+	Status         types.String            `tfsdk:"status"`
+	Types          []types.String          `tfsdk:"types"`
+	Operations     []OperationSummaryModel `tfsdk:"operations"`
+}
+
+type OperationSummaryModel struct {
+	OperationID   types.String `tfsdk:"operation_id"`
+	Type          types.String `tfsdk:"type"`
+	Status        types.String `tfsdk:"status"`
+	DomainName    types.String `tfsdk:"domain_name"`
+	SubmittedDate types.String `tfsdk:"submitted_date"`
+}
+
+func NewOperationsDataSource() datasource.DataSource {
+	return &OperationsDataSource{}
+}
+
+func (d *OperationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operations"
+}
+
+func (d *OperationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List Route53 Domains operations, optionally filtered by submission time, status and type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier derived from the filters.",
+			},
+			"submitted_since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return operations submitted at or after this time (RFC3339).",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return operations with this status: SUBMITTED, IN_PROGRESS, ERROR, SUCCESSFUL, or FAILED.",
+			},
+			"types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return operations of these types (e.g., REGISTER_DOMAIN, RENEW_DOMAIN).",
+			},
+			"operations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Matching operations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"operation_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The operation ID.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of operation (e.g., REGISTER_DOMAIN, UPDATE_NAMESERVER).",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The operation status.",
+						},
+						"domain_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The domain the operation applies to.",
+						},
+						"submitted_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date the operation was submitted (RFC3339).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OperationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.DomainsClient
+}
+
+func (d *OperationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OperationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &route53domains.ListOperationsInput{}
+
+	if !data.SubmittedSince.IsNull() {
+		since, err := time.Parse(time.RFC3339, data.SubmittedSince.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("submitted_since"),
+				"Invalid submitted_since",
+				fmt.Sprintf("Could not parse %q as an RFC3339 timestamp: %s", data.SubmittedSince.ValueString(), err.Error()),
+			)
+			return
+		}
+		input.SubmittedSince = aws.Time(since)
+	}
+
+	if !data.Status.IsNull() {
+		status := awstypes.OperationStatus(data.Status.ValueString())
+		valid := false
+		for _, s := range status.Values() {
+			if s == status {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status"),
+				"Invalid status",
+				fmt.Sprintf("%q is not a valid operation status. Expected one of %v.", data.Status.ValueString(), status.Values()),
+			)
+			return
+		}
+		input.Status = []awstypes.OperationStatus{status}
+	}
+
+	typeNames := make([]string, 0, len(data.Types))
+	for i, t := range data.Types {
+		opType := awstypes.OperationType(t.ValueString())
+		valid := false
+		for _, v := range opType.Values() {
+			if v == opType {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("types").AtListIndex(i),
+				"Invalid type",
+				fmt.Sprintf("%q is not a valid operation type. Expected one of %v.", t.ValueString(), opType.Values()),
+			)
+			return
+		}
+		input.Type = append(input.Type, opType)
+		typeNames = append(typeNames, t.ValueString())
+	}
+
+	paginator := route53domains.NewListOperationsPaginator(d.client, input)
+
+	operations := []OperationSummaryModel{}
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing operations",
+				fmt.Sprintf("Could not list domain operations: %s", err.Error()),
+			)
+			return
+		}
+
+		for _, op := range page.Operations {
+			summary := OperationSummaryModel{
+				OperationID:   types.StringPointerValue(op.OperationId),
+				Type:          types.StringValue(string(op.Type)),
+				Status:        types.StringValue(string(op.Status)),
+				DomainName:    types.StringPointerValue(op.DomainName),
+				SubmittedDate: types.StringNull(),
+			}
+			if op.SubmittedDate != nil {
+				summary.SubmittedDate = types.StringValue(formatTimestamp(*op.SubmittedDate))
+			}
+			operations = append(operations, summary)
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s|%s|%s", data.SubmittedSince.ValueString(), data.Status.ValueString(), strings.Join(typeNames, ",")))
+	data.Operations = operations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
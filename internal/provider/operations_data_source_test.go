@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOperationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOperationsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.awsdomains_operations.test", "operations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOperationsDataSourceConfig() string {
+	return `
+provider "awsdomains" {
+  region = "us-east-1"
+}
+
+data "awsdomains_operations" "test" {
+  submitted_since = "2020-01-01T00:00:00Z"
+}
+`
+}
+
+func TestOperationsDataSourceRead_paginatesWithFilters(t *testing.T) {
+	ctx := context.Background()
+	submitted := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	var inputs []*route53domains.ListOperationsInput
+	d := &OperationsDataSource{
+		client: &MockRoute53DomainsClient{
+			ListOperationsFunc: func(ctx context.Context, params *route53domains.ListOperationsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListOperationsOutput, error) {
+				inputs = append(inputs, params)
+				if params.Marker == nil {
+					return &route53domains.ListOperationsOutput{
+						Operations: []types.OperationSummary{
+							{
+								OperationId:   aws.String("op-1"),
+								Type:          types.OperationTypeRegisterDomain,
+								Status:        types.OperationStatusSuccessful,
+								DomainName:    aws.String("example.com"),
+								SubmittedDate: aws.Time(submitted),
+							},
+						},
+						NextPageMarker: aws.String("page-2"),
+					}, nil
+				}
+				return &route53domains.ListOperationsOutput{
+					Operations: []types.OperationSummary{
+						{
+							OperationId: aws.String("op-2"),
+							Type:        types.OperationTypeUpdateNameserver,
+							Status:      types.OperationStatusSuccessful,
+							DomainName:  aws.String("example.net"),
+						},
+					},
+				}, nil
+			},
+		},
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &OperationsDataSourceModel{
+		SubmittedSince: tftypes.StringValue("2026-01-01T00:00:00Z"),
+		Status:         tftypes.StringValue("SUCCESSFUL"),
+		Types:          []tftypes.String{tftypes.StringValue("REGISTER_DOMAIN"), tftypes.StringValue("UPDATE_NAMESERVER")},
+	})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	if len(inputs) != 2 || aws.ToString(inputs[1].Marker) != "page-2" {
+		t.Fatalf("Expected two pages with the second requested by marker, got %d calls", len(inputs))
+	}
+	if got := aws.ToTime(inputs[0].SubmittedSince); !got.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected SubmittedSince: %s", got)
+	}
+	if len(inputs[0].Status) != 1 || inputs[0].Status[0] != types.OperationStatusSuccessful {
+		t.Errorf("Unexpected Status filter: %v", inputs[0].Status)
+	}
+	for _, input := range inputs {
+		if len(input.Type) != 2 || input.Type[0] != types.OperationTypeRegisterDomain || input.Type[1] != types.OperationTypeUpdateNameserver {
+			t.Errorf("Unexpected Type filter: %v", input.Type)
+		}
+	}
+
+	var state OperationsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Could not read state: %v", resp.Diagnostics)
+	}
+
+	if len(state.Operations) != 2 {
+		t.Fatalf("Expected 2 operations, got %d", len(state.Operations))
+	}
+	first := state.Operations[0]
+	if first.OperationID.ValueString() != "op-1" || first.Type.ValueString() != "REGISTER_DOMAIN" || first.SubmittedDate.ValueString() != "2026-03-04T05:06:07Z" {
+		t.Errorf("Unexpected first operation: %+v", first)
+	}
+	if !state.Operations[1].SubmittedDate.IsNull() {
+		t.Errorf("Expected null submitted_date, got %s", state.Operations[1].SubmittedDate)
+	}
+}
+
+func TestOperationsDataSourceRead_invalidFilters(t *testing.T) {
+	tests := []struct {
+		name  string
+		model *OperationsDataSourceModel
+	}{
+		{"bad timestamp", &OperationsDataSourceModel{SubmittedSince: tftypes.StringValue("yesterday"), Status: tftypes.StringNull()}},
+		{"bad status", &OperationsDataSourceModel{SubmittedSince: tftypes.StringNull(), Status: tftypes.StringValue("DONE")}},
+		{"bad type", &OperationsDataSourceModel{SubmittedSince: tftypes.StringNull(), Status: tftypes.StringNull(), Types: []tftypes.String{tftypes.StringValue("REGISTER")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &OperationsDataSource{
+				client: &MockRoute53DomainsClient{
+					ListOperationsFunc: func(ctx context.Context, params *route53domains.ListOperationsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListOperationsOutput, error) {
+						t.Error("ListOperations should not be called with invalid filters")
+						return &route53domains.ListOperationsOutput{}, nil
+					},
+				},
+			}
+
+			req, resp := newDataSourceReadRequest(t, d, tt.model)
+			d.Read(context.Background(), req, resp)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected an error diagnostic")
+			}
+		})
+	}
+}
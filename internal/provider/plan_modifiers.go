@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var (
+	_ planmodifier.Int64 = durationYearsModifier{}
+	_ planmodifier.Bool  = allowDeleteWarningModifier{}
+)
+
+// durationYearsModifier rejects plans that lower duration_years. A
+// registration can only be extended by renewing it, never shortened. An
+// imported domain has no known duration, so its null state is kept while
+// duration_years is left unset rather than planning the default.
+type durationYearsModifier struct{}
+
+func (m durationYearsModifier) Description(ctx context.Context) string {
+	return "duration_years cannot be decreased after registration"
+}
+
+func (m durationYearsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m durationYearsModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() && !req.State.Raw.IsNull() && req.ConfigValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.PlanValue.ValueInt64() < req.StateValue.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Cannot shorten domain registration",
+			fmt.Sprintf("duration_years cannot be decreased from %d to %d. A registration can only be extended by renewal; increase duration_years to renew for the difference.", req.StateValue.ValueInt64(), req.PlanValue.ValueInt64()),
+		)
+	}
+}
+
+// allowDeleteWarningModifier warns on every plan that keeps allow_delete
+// enabled, so the risk shows in reviewed plan output and not only in the
+// configuration.
+type allowDeleteWarningModifier struct{}
+
+func (m allowDeleteWarningModifier) Description(ctx context.Context) string {
+	return "warns when destroying the resource would delete the domain registration"
+}
+
+func (m allowDeleteWarningModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m allowDeleteWarningModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.PlanValue.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Domain deletion enabled",
+		"allow_delete is true, so destroying this resource, or replacing it, will attempt to delete the domain registration with DeleteDomain. A deleted domain may not be recoverable and can be registered by anyone. Set allow_delete to false to only remove the domain from state on destroy.",
+	)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDurationYearsModifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		imported bool
+		state    tftypes.Int64
+		config   tftypes.Int64
+		plan     tftypes.Int64
+		wantPlan tftypes.Int64
+		wantErr  bool
+	}{
+		{"create", false, tftypes.Int64Null(), tftypes.Int64Value(2), tftypes.Int64Value(2), tftypes.Int64Value(2), false},
+		{"unchanged", false, tftypes.Int64Value(2), tftypes.Int64Value(2), tftypes.Int64Value(2), tftypes.Int64Value(2), false},
+		{"increase", false, tftypes.Int64Value(1), tftypes.Int64Value(3), tftypes.Int64Value(3), tftypes.Int64Value(3), false},
+		{"decrease", false, tftypes.Int64Value(3), tftypes.Int64Value(1), tftypes.Int64Value(1), tftypes.Int64Value(1), true},
+		{"unknown", false, tftypes.Int64Value(3), tftypes.Int64Unknown(), tftypes.Int64Unknown(), tftypes.Int64Unknown(), false},
+		{"imported with default", true, tftypes.Int64Null(), tftypes.Int64Null(), tftypes.Int64Value(1), tftypes.Int64Null(), false},
+		{"imported with configured value", true, tftypes.Int64Null(), tftypes.Int64Value(3), tftypes.Int64Value(3), tftypes.Int64Value(3), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.Int64Request{
+				Path:        path.Root("duration_years"),
+				StateValue:  tt.state,
+				ConfigValue: tt.config,
+				PlanValue:   tt.plan,
+			}
+			if tt.imported {
+				model := testDomainModel("example.com")
+				model.DurationYears = tftypes.Int64Null()
+				req.State = newResourceState(t, &DomainRegistrationResource{}, model)
+			}
+			resp := &planmodifier.Int64Response{PlanValue: tt.plan}
+			durationYearsModifier{}.PlanModifyInt64(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("Expected error=%v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.wantPlan) {
+				t.Errorf("Expected plan %s, got %s", tt.wantPlan, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestAllowDeleteWarningModifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     tftypes.Bool
+		wantWarn bool
+	}{
+		{"enabled", tftypes.BoolValue(true), true},
+		{"disabled", tftypes.BoolValue(false), false},
+		{"unknown", tftypes.BoolUnknown(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.BoolRequest{
+				Path:        path.Root("allow_delete"),
+				ConfigValue: tt.plan,
+				PlanValue:   tt.plan,
+			}
+			resp := &planmodifier.BoolResponse{PlanValue: tt.plan}
+			allowDeleteWarningModifier{}.PlanModifyBool(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+			}
+			warned := resp.Diagnostics.WarningsCount() == 1 && resp.Diagnostics.Warnings()[0].Summary() == "Domain deletion enabled"
+			if warned != tt.wantWarn {
+				t.Errorf("Expected warning=%v, got %v", tt.wantWarn, resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.plan) {
+				t.Errorf("Expected the plan to be unchanged, got %s", resp.PlanValue)
+			}
+		})
+	}
+}
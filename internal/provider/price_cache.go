@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+)
+
+// defaultPriceCacheTTL is how long the ListPrices listing is reused before
+// ListPrices is called again.
+const defaultPriceCacheTTL = 15 * time.Minute
+
+// PriceCache memoizes a single unfiltered ListPrices listing so that every
+// price data source in one configuration, whatever its TLD, shares one
+// pagination. It is safe for concurrent use; concurrent lookups wait for the
+// first fetch instead of issuing their own.
+type PriceCache struct {
+	ttl   time.Duration
+	mu    sync.Mutex
+	entry *priceCacheEntry
+}
+
+type priceCacheEntry struct {
+	done    chan struct{}
+	prices  []types.DomainPrice
+	byTLD   map[string]*types.DomainPrice
+	err     error
+	expires time.Time
+}
+
+// NewPriceCache returns an empty cache whose listing expires after ttl.
+func NewPriceCache(ttl time.Duration) *PriceCache {
+	return &PriceCache{ttl: ttl}
+}
+
+// Prices returns the cached price listing, calling fetch to populate the cache
+// on first use or after expiry. Errors are not cached. A nil cache always
+// calls fetch.
+func (c *PriceCache) Prices(ctx context.Context, fetch func(ctx context.Context) ([]types.DomainPrice, error)) ([]types.DomainPrice, error) {
+	entry, err := c.load(ctx, fetch)
+	if err != nil {
+		return nil, err
+	}
+	return entry.prices, nil
+}
+
+// Get returns the cached price for tld, populating the whole listing with fetch
+// on a miss or after expiry. TLDs are compared after normalizeTLD, so "com",
+// ".com" and "COM" match the same price. A nil price with a nil error means
+// the TLD has no pricing.
+func (c *PriceCache) Get(ctx context.Context, tld string, fetch func(ctx context.Context) ([]types.DomainPrice, error)) (*types.DomainPrice, error) {
+	entry, err := c.load(ctx, fetch)
+	if err != nil {
+		return nil, err
+	}
+	return entry.byTLD[normalizeTLD(tld)], nil
+}
+
+func (c *PriceCache) load(ctx context.Context, fetch func(ctx context.Context) ([]types.DomainPrice, error)) (*priceCacheEntry, error) {
+	if c == nil {
+		entry := &priceCacheEntry{}
+		entry.fill(fetch(ctx))
+		return entry, entry.err
+	}
+
+	c.mu.Lock()
+	entry := c.entry
+	ok := entry != nil
+	if ok {
+		select {
+		case <-entry.done:
+			if time.Now().After(entry.expires) {
+				ok = false
+			}
+		default:
+			// Fetch in flight; wait for it below.
+		}
+	}
+	if !ok {
+		entry = &priceCacheEntry{done: make(chan struct{})}
+		c.entry = entry
+		c.mu.Unlock()
+
+		entry.fill(fetch(ctx))
+		entry.expires = time.Now().Add(c.ttl)
+		if entry.err != nil {
+			c.mu.Lock()
+			if c.entry == entry {
+				c.entry = nil
+			}
+			c.mu.Unlock()
+		}
+		close(entry.done)
+		return entry, entry.err
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-entry.done:
+		return entry, entry.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (e *priceCacheEntry) fill(prices []types.DomainPrice, err error) {
+	e.prices = prices
+	e.err = err
+	e.byTLD = make(map[string]*types.DomainPrice, len(prices))
+	for i := range prices {
+		if prices[i].Name != nil {
+			e.byTLD[normalizeTLD(*prices[i].Name)] = &prices[i]
+		}
+	}
+}
+
+// normalizeTLD lowercases a TLD and strips any leading dot, as ListPrices does
+// not consistently return one form.
+func normalizeTLD(tld string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tld), "."))
+}
+
+// listAllPrices pages through ListPrices without a TLD filter, which returns
+// every supported TLD.
+func listAllPrices(ctx context.Context, client Route53DomainsAPI) ([]types.DomainPrice, error) {
+	paginator := route53domains.NewListPricesPaginator(client, &route53domains.ListPricesInput{})
+
+	var prices []types.DomainPrice
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, page.Prices...)
+	}
+	return prices, nil
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+)
+
+func TestPriceCacheConcurrentLookups(t *testing.T) {
+	cache := NewPriceCache(time.Minute)
+	var calls int32
+
+	fetch := func(ctx context.Context) ([]types.DomainPrice, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return []types.DomainPrice{{Name: aws.String("com")}, {Name: aws.String("net")}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		tld := "com"
+		if i%2 == 1 {
+			tld = "net"
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			price, err := cache.Get(context.Background(), tld, fetch)
+			if err != nil || price == nil || aws.ToString(price.Name) != tld {
+				t.Errorf("Unexpected result for %s: %v, %v", tld, price, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Expected 1 fetch, got %d", calls)
+	}
+}
+
+func TestPriceCacheExpiry(t *testing.T) {
+	cache := NewPriceCache(0)
+	calls := 0
+	fetch := func(ctx context.Context) ([]types.DomainPrice, error) {
+		calls++
+		return nil, nil
+	}
+
+	_, _ = cache.Get(context.Background(), "com", fetch)
+	time.Sleep(time.Millisecond)
+	_, _ = cache.Get(context.Background(), "com", fetch)
+
+	if calls != 2 {
+		t.Errorf("Expected expired entry to be refetched, got %d fetches", calls)
+	}
+}
+
+func TestPriceCacheDoesNotCacheErrors(t *testing.T) {
+	cache := NewPriceCache(time.Minute)
+	calls := 0
+	fetch := func(ctx context.Context) ([]types.DomainPrice, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("ThrottlingException")
+		}
+		return nil, nil
+	}
+
+	if _, err := cache.Get(context.Background(), "com", fetch); err == nil {
+		t.Fatal("Expected first lookup to fail")
+	}
+	if _, err := cache.Get(context.Background(), "com", fetch); err != nil {
+		t.Fatalf("Expected second lookup to succeed, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 fetches, got %d", calls)
+	}
+}
+
+func TestPriceCacheNil(t *testing.T) {
+	var cache *PriceCache
+	calls := 0
+	fetch := func(ctx context.Context) ([]types.DomainPrice, error) {
+		calls++
+		return nil, nil
+	}
+
+	_, _ = cache.Get(context.Background(), "com", fetch)
+	_, _ = cache.Get(context.Background(), "com", fetch)
+
+	if calls != 2 {
+		t.Errorf("Expected nil cache to always fetch, got %d fetches", calls)
+	}
+}
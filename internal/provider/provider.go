@@ -2,32 +2,127 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/logging"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = &AWSDomainsProvider{}
 
+// domainsRegion is the region the Route53 Domains API is served from in the
+// standard aws partition.
+const domainsRegion = "us-east-1"
+
+// partitionDomainsRegions maps each partition to the one region its Route53
+// Domains API is served from. The SDK derives the endpoint, including the
+// partition's DNS suffix, from the region.
+var partitionDomainsRegions = map[string]string{
+	"aws":        domainsRegion,
+	"aws-us-gov": "us-gov-west-1",
+	"aws-cn":     "cn-northwest-1",
+}
+
+// defaultMaxRetries is the number of retries for failed AWS calls when
+// max_retries is not set.
+const defaultMaxRetries = 3
+
 type AWSDomainsProvider struct {
 	version string
 }
 
 type AWSDomainsProviderModel struct {
-	Region  types.String `tfsdk:"region"`
-	Profile types.String `tfsdk:"profile"`
+	Region                 types.String            `tfsdk:"region"`
+	Partition              types.String            `tfsdk:"partition"`
+	Profile                types.String            `tfsdk:"profile"`
+	SharedCredentialsFiles []types.String          `tfsdk:"shared_credentials_files"`
+	Route53DomainsEndpoint types.String            `tfsdk:"route53domains_endpoint"`
+	Route53Endpoint        types.String            `tfsdk:"route53_endpoint"`
+	MaxRetries             types.Int64             `tfsdk:"max_retries"`
+	HTTPTimeout            types.String            `tfsdk:"http_timeout"`
+	DebugAPI               types.Bool              `tfsdk:"debug_api"`
+	ValidateCredentials    types.Bool              `tfsdk:"validate_credentials"`
+	DefaultTags            *DefaultTagsModel       `tfsdk:"default_tags"`
+	ContactTemplates       map[string]ContactModel `tfsdk:"contact_templates"`
+}
+
+// DefaultTagsModel is the provider's default_tags block.
+type DefaultTagsModel struct {
+	Tags map[string]types.String `tfsdk:"tags"`
 }
 
 // ProviderData holds the AWS clients passed to resources and data sources
 type ProviderData struct {
-	DomainsClient *route53domains.Client
-	Route53Client *route53.Client
+	DomainsClient Route53DomainsAPI
+	Route53Client Route53API
+	PriceCache    *PriceCache
+	// DefaultTags are merged into the tags of every domain's hosted zone
+	DefaultTags map[string]string
+	// ContactTemplates are the contacts domains refer to by name with
+	// admin_contact_template, registrant_contact_template and
+	// tech_contact_template
+	ContactTemplates map[string]ContactModel
+}
+
+// Route53API is the subset of the Route53 client used to manage the hosted
+// zone created by the registrar. It is satisfied by *route53.Client.
+type Route53API interface {
+	ChangeResourceRecordSets(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error)
+	ChangeTagsForResource(ctx context.Context, params *route53.ChangeTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ChangeTagsForResourceOutput, error)
+	DeleteHostedZone(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error)
+	ListHostedZonesByName(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error)
+	ListResourceRecordSets(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error)
+	ListTagsForResource(ctx context.Context, params *route53.ListTagsForResourceInput, optFns ...func(*route53.Options)) (*route53.ListTagsForResourceOutput, error)
+}
+
+// Route53DomainsAPI is the subset of the Route53 Domains client used by the
+// provider. It is satisfied by *route53domains.Client and allows tests to
+// inject a mock.
+type Route53DomainsAPI interface {
+	AcceptDomainTransferFromAnotherAwsAccount(ctx context.Context, params *route53domains.AcceptDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.AcceptDomainTransferFromAnotherAwsAccountOutput, error)
+	AssociateDelegationSignerToDomain(ctx context.Context, params *route53domains.AssociateDelegationSignerToDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.AssociateDelegationSignerToDomainOutput, error)
+	CancelDomainTransferToAnotherAwsAccount(ctx context.Context, params *route53domains.CancelDomainTransferToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.CancelDomainTransferToAnotherAwsAccountOutput, error)
+	CheckDomainAvailability(ctx context.Context, params *route53domains.CheckDomainAvailabilityInput, optFns ...func(*route53domains.Options)) (*route53domains.CheckDomainAvailabilityOutput, error)
+	DeleteDomain(ctx context.Context, params *route53domains.DeleteDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DeleteDomainOutput, error)
+	DisableDomainAutoRenew(ctx context.Context, params *route53domains.DisableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainAutoRenewOutput, error)
+	DisableDomainTransferLock(ctx context.Context, params *route53domains.DisableDomainTransferLockInput, optFns ...func(*route53domains.Options)) (*route53domains.DisableDomainTransferLockOutput, error)
+	DisassociateDelegationSignerFromDomain(ctx context.Context, params *route53domains.DisassociateDelegationSignerFromDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.DisassociateDelegationSignerFromDomainOutput, error)
+	EnableDomainAutoRenew(ctx context.Context, params *route53domains.EnableDomainAutoRenewInput, optFns ...func(*route53domains.Options)) (*route53domains.EnableDomainAutoRenewOutput, error)
+	GetContactReachabilityStatus(ctx context.Context, params *route53domains.GetContactReachabilityStatusInput, optFns ...func(*route53domains.Options)) (*route53domains.GetContactReachabilityStatusOutput, error)
+	GetDomainDetail(ctx context.Context, params *route53domains.GetDomainDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetDomainDetailOutput, error)
+	GetOperationDetail(ctx context.Context, params *route53domains.GetOperationDetailInput, optFns ...func(*route53domains.Options)) (*route53domains.GetOperationDetailOutput, error)
+	ListDomains(ctx context.Context, params *route53domains.ListDomainsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListDomainsOutput, error)
+	ListOperations(ctx context.Context, params *route53domains.ListOperationsInput, optFns ...func(*route53domains.Options)) (*route53domains.ListOperationsOutput, error)
+	ListPrices(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error)
+	RegisterDomain(ctx context.Context, params *route53domains.RegisterDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RegisterDomainOutput, error)
+	RejectDomainTransferFromAnotherAwsAccount(ctx context.Context, params *route53domains.RejectDomainTransferFromAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.RejectDomainTransferFromAnotherAwsAccountOutput, error)
+	RenewDomain(ctx context.Context, params *route53domains.RenewDomainInput, optFns ...func(*route53domains.Options)) (*route53domains.RenewDomainOutput, error)
+	ResendContactReachabilityEmail(ctx context.Context, params *route53domains.ResendContactReachabilityEmailInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendContactReachabilityEmailOutput, error)
+	ResendOperationAuthorization(ctx context.Context, params *route53domains.ResendOperationAuthorizationInput, optFns ...func(*route53domains.Options)) (*route53domains.ResendOperationAuthorizationOutput, error)
+	TransferDomainToAnotherAwsAccount(ctx context.Context, params *route53domains.TransferDomainToAnotherAwsAccountInput, optFns ...func(*route53domains.Options)) (*route53domains.TransferDomainToAnotherAwsAccountOutput, error)
+	UpdateDomainContact(ctx context.Context, params *route53domains.UpdateDomainContactInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactOutput, error)
+	UpdateDomainContactPrivacy(ctx context.Context, params *route53domains.UpdateDomainContactPrivacyInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainContactPrivacyOutput, error)
+	UpdateDomainNameservers(ctx context.Context, params *route53domains.UpdateDomainNameserversInput, optFns ...func(*route53domains.Options)) (*route53domains.UpdateDomainNameserversOutput, error)
 }
 
 func New(version string) func() provider.Provider {
@@ -48,13 +143,65 @@ func (p *AWSDomainsProvider) Schema(ctx context.Context, req provider.SchemaRequ
 		Description: "Provider for managing AWS Route53 domain registrations with full lifecycle support.",
 		Attributes: map[string]schema.Attribute{
 			"region": schema.StringAttribute{
-				Description: "AWS region for Route53 Domains API (must be us-east-1).",
+				Description: "AWS region for Route53 Domains API (must be the partition's domains region, us-east-1 in the aws partition, unless route53domains_endpoint is set).",
+				Optional:    true,
+			},
+			"partition": schema.StringAttribute{
+				Description: "AWS partition: aws, aws-us-gov or aws-cn. Selects the region, and so the endpoint, used for the Route53 Domains API. Defaults to the partition of region, or aws.",
 				Optional:    true,
 			},
 			"profile": schema.StringAttribute{
 				Description: "AWS profile to use for authentication.",
 				Optional:    true,
 			},
+			"shared_credentials_files": schema.ListAttribute{
+				Description: "Paths to shared credentials files to use instead of the default ~/.aws/credentials. The profile is looked up in these files.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"route53domains_endpoint": schema.StringAttribute{
+				Description: "Custom endpoint URL for the Route53 Domains API (e.g., a LocalStack or moto server for testing).",
+				Optional:    true,
+			},
+			"route53_endpoint": schema.StringAttribute{
+				Description: "Custom endpoint URL for the Route53 API (e.g., a LocalStack or moto server for testing).",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a failed AWS API call is retried (default: 3). Clients use adaptive retry mode, which also rate limits requests after throttling.",
+				Optional:    true,
+			},
+			"http_timeout": schema.StringAttribute{
+				Description: "Timeout for each HTTP request made to AWS, as a duration (e.g., \"30s\", \"2m\"). A request that takes longer fails and is retried under max_retries. Defaults to no timeout.",
+				Optional:    true,
+			},
+			"debug_api": schema.BoolAttribute{
+				Description: "Log every AWS API request and response through the provider's debug log (visible with TF_LOG=DEBUG). Defaults to false.",
+				Optional:    true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "Make one ListPrices call when the provider is configured, so wrong credentials or missing permissions fail before any resource is planned. Defaults to false.",
+				Optional:    true,
+			},
+			"contact_templates": schema.MapNestedAttribute{
+				Description: "Contacts defined once and used by any awsdomains_domain that names them in admin_contact_template, registrant_contact_template or tech_contact_template, keyed by template name.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: contactTemplateAttributes(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				Description: "Tags applied to the registrar hosted zone of every awsdomains_domain, merged with the resource's tags. A resource tag with the same key wins.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						Description: "Default tags. Values not known until apply are ignored.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
 		},
 	}
 }
@@ -67,18 +214,92 @@ func (p *AWSDomainsProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	maxRetries := defaultMaxRetries
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid max_retries",
+				fmt.Sprintf("max_retries must be zero or greater, got %d.", data.MaxRetries.ValueInt64()),
+			)
+			return
+		}
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	var httpTimeout time.Duration
+	if !data.HTTPTimeout.IsNull() && !data.HTTPTimeout.IsUnknown() {
+		d, err := time.ParseDuration(data.HTTPTimeout.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_timeout"),
+				"Invalid http_timeout",
+				fmt.Sprintf("http_timeout must be a positive duration such as \"30s\", got %q.", data.HTTPTimeout.ValueString()),
+			)
+			return
+		}
+		httpTimeout = d
+	}
+
 	// Build AWS config options
 	var optFns []func(*config.LoadOptions) error
 
-	// Route53 Domains API only works in us-east-1
-	region := "us-east-1"
-	if !data.Region.IsNull() {
-		region = data.Region.ValueString()
+	// Route53 Domains API only works in one region per partition. Values that
+	// are not known until apply (e.g. derived from another resource) are not
+	// checked.
+	region, source, err := resolveRegion(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create AWS config",
+			"An error occurred while reading the AWS region from shared config: "+err.Error(),
+		)
+		return
+	}
+	partition := partitionForRegion(region)
+	if !data.Partition.IsNull() && !data.Partition.IsUnknown() {
+		partition = data.Partition.ValueString()
+		if _, ok := partitionDomainsRegions[partition]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("partition"),
+				"Unsupported partition",
+				fmt.Sprintf("partition must be one of aws, aws-us-gov or aws-cn, got %q.", partition),
+			)
+			return
+		}
+	}
+	partitionRegion := partitionDomainsRegions[partition]
+	customEndpoint := data.Route53DomainsEndpoint.ValueString() != "" || data.Route53DomainsEndpoint.IsUnknown()
+	switch {
+	case region == "":
+		region = partitionRegion
+	case region == partitionRegion || customEndpoint:
+	case source == regionSourceArgument:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unsupported region",
+			fmt.Sprintf("The Route53 Domains API is only available in %s in the %s partition, but region is set to %q. Remove the region argument or set it to %s. "+
+				"To use another region against a custom endpoint (e.g. LocalStack), set route53domains_endpoint.", partitionRegion, partition, region, partitionRegion),
+		)
+		return
+	default:
+		// A region set for other tools only selects the partition
+		tflog.Info(ctx, "Using the partition's Route53 Domains region", map[string]interface{}{
+			"region":         region,
+			"region_source":  source,
+			"domains_region": partitionRegion,
+		})
+		region = partitionRegion
 	}
 	optFns = append(optFns, config.WithRegion(region))
 
-	if !data.Profile.IsNull() {
-		optFns = append(optFns, config.WithSharedConfigProfile(data.Profile.ValueString()))
+	optFns = append(optFns, sharedConfigOptions(data)...)
+	optFns = append(optFns, withMaxRetries(maxRetries))
+	optFns = append(optFns, withUserAgent(p.version))
+	if httpTimeout > 0 {
+		optFns = append(optFns, withHTTPTimeout(httpTimeout))
+	}
+	if data.DebugAPI.ValueBool() {
+		optFns = append(optFns, withAPILogging(ctx)...)
 	}
 
 	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
@@ -90,12 +311,25 @@ func (p *AWSDomainsProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	domainsClient := route53domains.NewFromConfig(cfg)
-	route53Client := route53.NewFromConfig(cfg)
+	domainsClient, route53Client := newAWSClients(cfg, data)
+
+	if data.ValidateCredentials.ValueBool() {
+		if err := validateCredentials(ctx, domainsClient); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("validate_credentials"),
+				"Unable to validate AWS credentials",
+				fmt.Sprintf("The Route53 Domains API rejected a test ListPrices call in %s: %s. %s", region, err.Error(), credentialsHint(err, configuredProfile(data))),
+			)
+			return
+		}
+	}
 
 	providerData := &ProviderData{
-		DomainsClient: domainsClient,
-		Route53Client: route53Client,
+		DomainsClient:    domainsClient,
+		Route53Client:    route53Client,
+		PriceCache:       NewPriceCache(defaultPriceCacheTTL),
+		DefaultTags:      defaultTags(ctx, data.DefaultTags),
+		ContactTemplates: data.ContactTemplates,
 	}
 
 	resp.DataSourceData = providerData
@@ -105,12 +339,253 @@ func (p *AWSDomainsProvider) Configure(ctx context.Context, req provider.Configu
 func (p *AWSDomainsProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDomainRegistrationResource,
+		NewAutoRenewalPolicyResource,
+		NewDomainAccountTransferResource,
+		NewDomainRenewalResource,
+		NewDomainTransferAcceptanceResource,
+		NewOperationAuthorizationResource,
 	}
 }
 
 func (p *AWSDomainsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDomainAvailabilityDataSource,
+		NewDomainAvailabilitiesDataSource,
+		NewDomainContactsDataSource,
 		NewDomainPriceDataSource,
+		NewDomainPricesDataSource,
+		NewOperationDataSource,
+		NewOperationsDataSource,
+		NewSupportedTLDsDataSource,
+		NewTLDRequirementsDataSource,
+	}
+}
+
+// newAWSClients builds the Route53 Domains and Route53 clients, applying any
+// endpoint overrides from the provider configuration.
+func newAWSClients(cfg aws.Config, data AWSDomainsProviderModel) (*route53domains.Client, *route53.Client) {
+	domainsClient := route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
+		if !data.Route53DomainsEndpoint.IsNull() && data.Route53DomainsEndpoint.ValueString() != "" {
+			o.BaseEndpoint = aws.String(data.Route53DomainsEndpoint.ValueString())
+		}
+	})
+	route53Client := route53.NewFromConfig(cfg, func(o *route53.Options) {
+		if !data.Route53Endpoint.IsNull() && data.Route53Endpoint.ValueString() != "" {
+			o.BaseEndpoint = aws.String(data.Route53Endpoint.ValueString())
+		}
+	})
+	return domainsClient, route53Client
+}
+
+// partitionForRegion returns the partition a region belongs to, defaulting to
+// aws for an empty or standard region.
+func partitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	default:
+		return "aws"
+	}
+}
+
+// regionSourceArgument is the source resolveRegion reports for a region set
+// by the region argument.
+const regionSourceArgument = "region"
+
+// resolveRegion returns the configured region and where it was set, in order
+// of precedence: the region argument, the AWS_REGION and AWS_DEFAULT_REGION
+// environment variables, then the region of the profile in shared config. An
+// unknown region argument resolves to no region.
+func resolveRegion(ctx context.Context, data AWSDomainsProviderModel) (region string, source string, err error) {
+	if data.Region.IsUnknown() {
+		return "", regionSourceArgument, nil
+	}
+	if !data.Region.IsNull() && data.Region.ValueString() != "" {
+		return data.Region.ValueString(), regionSourceArgument, nil
+	}
+	for _, env := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if v := os.Getenv(env); v != "" {
+			return v, env, nil
+		}
+	}
+
+	// With the environment unset, the region the SDK resolves is the
+	// profile's, honouring profile over AWS_PROFILE
+	cfg, err := config.LoadDefaultConfig(ctx, sharedConfigOptions(data)...)
+	if err != nil {
+		return "", "", err
+	}
+	return cfg.Region, "shared config", nil
+}
+
+// sharedConfigOptions returns the load options selecting the shared config
+// profile and the shared credentials files it is read from.
+func sharedConfigOptions(data AWSDomainsProviderModel) []func(*config.LoadOptions) error {
+	var optFns []func(*config.LoadOptions) error
+	if !data.Profile.IsNull() {
+		optFns = append(optFns, config.WithSharedConfigProfile(data.Profile.ValueString()))
+	}
+	if len(data.SharedCredentialsFiles) > 0 {
+		files := make([]string, 0, len(data.SharedCredentialsFiles))
+		for _, f := range data.SharedCredentialsFiles {
+			files = append(files, f.ValueString())
+		}
+		optFns = append(optFns, config.WithSharedCredentialsFiles(files))
+	}
+	return optFns
+}
+
+// withMaxRetries configures the shared clients with an adaptive retryer that
+// makes up to maxRetries retries after the initial attempt. Adaptive mode
+// backs off client-side once throttling starts, which suits the low Route53
+// Domains rate limits.
+func withMaxRetries(maxRetries int) func(*config.LoadOptions) error {
+	return config.WithRetryer(func() aws.Retryer {
+		return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+			o.StandardOptions = append(o.StandardOptions, func(so *retry.StandardOptions) {
+				so.MaxAttempts = maxRetries + 1
+			})
+		})
+	})
+}
+
+// validateCredentials makes the cheapest Route53 Domains call, a one-item
+// ListPrices page for one TLD, so credential and permission problems surface
+// before the first resource operation.
+func validateCredentials(ctx context.Context, client Route53DomainsAPI) error {
+	_, err := client.ListPrices(ctx, &route53domains.ListPricesInput{
+		Tld:      aws.String("com"),
+		MaxItems: aws.Int32(1),
+	})
+	return err
+}
+
+// credentialsHint suggests a fix for a failed validateCredentials call made
+// with the given shared config profile.
+func credentialsHint(err error, profile string) string {
+	if isSSOTokenError(err) {
+		login := "aws sso login"
+		if profile != "" {
+			login += " --profile " + profile
+		}
+		return fmt.Sprintf("The IAM Identity Center (SSO) session has expired or was never started; run %q and try again.", login)
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "AccessDeniedException", "AccessDenied":
+			return "The credentials are valid but lack route53domains:ListPrices; see the provider documentation for the required IAM permissions."
+		case "UnrecognizedClientException", "InvalidClientTokenId", "InvalidSignatureException", "SignatureDoesNotMatch", "ExpiredTokenException":
+			return "Check the access key, secret key and session token in use, or that the profile's credentials have not expired."
+		}
 	}
+	return "Check the provider's credentials, profile and region."
+}
+
+// isSSOTokenError reports whether err comes from a missing, expired or
+// unrefreshable IAM Identity Center token. A legacy SSO profile reports an
+// InvalidTokenError; one using an sso-session, whose cached token the SDK
+// refreshes itself, only describes the cached token in its message.
+func isSSOTokenError(err error) bool {
+	var invalidToken *ssocreds.InvalidTokenError
+	return errors.As(err, &invalidToken) || strings.Contains(err.Error(), "cached SSO token")
+}
+
+// configuredProfile returns the shared config profile in use: profile, then
+// AWS_PROFILE. Empty means the default profile.
+func configuredProfile(data AWSDomainsProviderModel) string {
+	if !data.Profile.IsNull() && data.Profile.ValueString() != "" {
+		return data.Profile.ValueString()
+	}
+	return os.Getenv("AWS_PROFILE")
+}
+
+// contactTemplateAttributes returns the attributes of a contact template,
+// built from the same contactFields as a contact on awsdomains_domain.
+func contactTemplateAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(contactFields))
+	for _, f := range contactFields {
+		if f.stringMap {
+			attributes[f.name] = schema.MapAttribute{
+				Required:    f.required,
+				Optional:    !f.required,
+				ElementType: types.StringType,
+				Description: f.description,
+			}
+			continue
+		}
+		attributes[f.name] = schema.StringAttribute{
+			Required:    f.required,
+			Optional:    !f.required,
+			Description: f.description,
+			Validators:  f.validators,
+		}
+	}
+	return attributes
+}
+
+// defaultTags returns the known values of the default_tags block. Values not
+// known until apply cannot be merged into a plan, so they are skipped.
+func defaultTags(ctx context.Context, block *DefaultTagsModel) map[string]string {
+	if block == nil || len(block.Tags) == 0 {
+		return nil
+	}
+	tags := make(map[string]string, len(block.Tags))
+	for key, value := range block.Tags {
+		if value.IsUnknown() || value.IsNull() {
+			tflog.Warn(ctx, "Ignoring default tag without a known value", map[string]interface{}{
+				"key": key,
+			})
+			continue
+		}
+		tags[key] = value.ValueString()
+	}
+	return tags
+}
+
+// userAgentName identifies the provider in the User-Agent of its API calls.
+const userAgentName = "terraform-provider-awsdomains"
+
+// withUserAgent appends the provider name and version to the User-Agent of
+// every call the shared clients make, so AWS support can tell which provider
+// release a request came from.
+func withUserAgent(version string) func(*config.LoadOptions) error {
+	return config.WithAPIOptions([]func(*middleware.Stack) error{
+		awsmiddleware.AddUserAgentKeyValue(userAgentName, version),
+	})
+}
+
+// withHTTPTimeout configures the shared clients with an HTTP client that gives
+// up on a request after timeout, so a hung connection fails the call instead
+// of blocking the apply. The SDK's default transport settings are kept.
+func withHTTPTimeout(timeout time.Duration) func(*config.LoadOptions) error {
+	return config.WithHTTPClient(awshttp.NewBuildableClient().WithTimeout(timeout))
+}
+
+// withAPILogging makes the shared clients log each request and response, without
+// bodies, through tflog at debug level.
+func withAPILogging(ctx context.Context) []func(*config.LoadOptions) error {
+	return []func(*config.LoadOptions) error{
+		config.WithClientLogMode(aws.LogRequest | aws.LogResponse),
+		config.WithLogger(tflogLogger{ctx: ctx}),
+	}
+}
+
+// tflogLogger adapts tflog to the SDK's logger. The SDK passes each request's
+// context through WithContext, so entries carry the calling resource's log
+// fields rather than the provider's.
+type tflogLogger struct {
+	ctx context.Context
+}
+
+func (l tflogLogger) Logf(classification logging.Classification, format string, v ...interface{}) {
+	tflog.Debug(l.ctx, fmt.Sprintf(format, v...), map[string]interface{}{
+		"aws_log_classification": string(classification),
+	})
+}
+
+func (l tflogLogger) WithContext(ctx context.Context) logging.Logger {
+	return tflogLogger{ctx: ctx}
 }
@@ -2,11 +2,35 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	pschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -37,6 +61,508 @@ func TestProviderSchema(t *testing.T) {
 	if _, ok := attrs["profile"]; !ok {
 		t.Error("Schema missing 'profile' attribute")
 	}
+	for _, name := range []string{"partition", "route53domains_endpoint", "route53_endpoint", "max_retries", "shared_credentials_files"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("Schema missing '%s' attribute", name)
+		}
+	}
+}
+
+func TestContactTemplateAttributesMatchContactSchema(t *testing.T) {
+	contact := contactSchema("").Attributes
+	template := contactTemplateAttributes()
+	if len(template) != len(contact) {
+		t.Fatalf("Expected %d template attributes, got %d", len(contact), len(template))
+	}
+	for name, want := range contact {
+		got, ok := template[name]
+		if !ok {
+			t.Errorf("contact_templates is missing %s", name)
+			continue
+		}
+		if got.IsRequired() != want.IsRequired() || got.IsOptional() != want.IsOptional() {
+			t.Errorf("%s: expected required %t and optional %t, got %t and %t", name, want.IsRequired(), want.IsOptional(), got.IsRequired(), got.IsOptional())
+		}
+		if got.GetDescription() != want.GetDescription() || !got.GetType().Equal(want.GetType()) {
+			t.Errorf("%s: expected %q of type %s, got %q of type %s", name, want.GetDescription(), want.GetType(), got.GetDescription(), got.GetType())
+		}
+		wantString, _ := want.(rschema.StringAttribute)
+		gotString, _ := got.(pschema.StringAttribute)
+		if len(gotString.StringValidators()) != len(wantString.StringValidators()) {
+			t.Errorf("%s: expected %d validators, got %d", name, len(wantString.StringValidators()), len(gotString.StringValidators()))
+		}
+	}
+}
+
+func TestWithMaxRetries(t *testing.T) {
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(domainsRegion), withMaxRetries(5))
+	if err != nil {
+		t.Fatalf("Could not load config: %s", err)
+	}
+
+	retryer := cfg.Retryer()
+	if _, ok := retryer.(*retry.AdaptiveMode); !ok {
+		t.Errorf("Expected an adaptive retryer, got %T", retryer)
+	}
+	if got := retryer.MaxAttempts(); got != 6 {
+		t.Errorf("Expected 6 attempts for max_retries = 5, got %d", got)
+	}
+}
+
+func TestWithHTTPTimeout(t *testing.T) {
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(domainsRegion), withHTTPTimeout(45*time.Second))
+	if err != nil {
+		t.Fatalf("Could not load config: %s", err)
+	}
+
+	client, ok := cfg.HTTPClient.(*awshttp.BuildableClient)
+	if !ok {
+		t.Fatalf("Expected a buildable HTTP client, got %T", cfg.HTTPClient)
+	}
+	if got := client.GetTimeout(); got != 45*time.Second {
+		t.Errorf("Expected a 45s timeout, got %s", got)
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	cfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(domainsRegion), withUserAgent("1.2.3"))
+	if err != nil {
+		t.Fatalf("Could not load config: %s", err)
+	}
+	if len(cfg.APIOptions) != 1 {
+		t.Fatalf("Expected one API option, got %d", len(cfg.APIOptions))
+	}
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := cfg.APIOptions[0](stack); err != nil {
+		t.Fatalf("Could not apply API option: %s", err)
+	}
+	m, ok := stack.Build.Get("UserAgent")
+	if !ok {
+		t.Fatal("Expected the user agent middleware to be registered")
+	}
+
+	req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+	_, _, err = m.HandleBuild(context.Background(), middleware.BuildInput{Request: req}, middleware.BuildHandlerFunc(
+		func(ctx context.Context, in middleware.BuildInput) (middleware.BuildOutput, middleware.Metadata, error) {
+			return middleware.BuildOutput{}, middleware.Metadata{}, nil
+		},
+	))
+	if err != nil {
+		t.Fatalf("User agent middleware failed: %s", err)
+	}
+	if ua := req.Header.Get("User-Agent"); !strings.Contains(ua, "terraform-provider-awsdomains/1.2.3") {
+		t.Errorf("Expected the provider version in the User-Agent, got %q", ua)
+	}
+}
+
+func TestWithAPILogging(t *testing.T) {
+	cfg, err := config.LoadDefaultConfig(context.Background(), append([]func(*config.LoadOptions) error{config.WithRegion(domainsRegion)}, withAPILogging(context.Background())...)...)
+	if err != nil {
+		t.Fatalf("Could not load config: %s", err)
+	}
+
+	if cfg.ClientLogMode != aws.LogRequest|aws.LogResponse {
+		t.Errorf("Expected request and response logging, got %v", cfg.ClientLogMode)
+	}
+	if _, ok := cfg.Logger.(tflogLogger); !ok {
+		t.Errorf("Expected the tflog logger, got %T", cfg.Logger)
+	}
+}
+
+func TestSharedConfigOptions(t *testing.T) {
+	var opts config.LoadOptions
+	for _, fn := range sharedConfigOptions(AWSDomainsProviderModel{
+		Profile:                types.StringValue("pipeline"),
+		SharedCredentialsFiles: []types.String{types.StringValue("/secrets/aws/credentials")},
+	}) {
+		if err := fn(&opts); err != nil {
+			t.Fatalf("Option returned error: %s", err)
+		}
+	}
+
+	if opts.SharedConfigProfile != "pipeline" {
+		t.Errorf("Expected profile pipeline, got %q", opts.SharedConfigProfile)
+	}
+	if len(opts.SharedCredentialsFiles) != 1 || opts.SharedCredentialsFiles[0] != "/secrets/aws/credentials" {
+		t.Errorf("Expected credentials file /secrets/aws/credentials, got %v", opts.SharedCredentialsFiles)
+	}
+
+	if got := sharedConfigOptions(AWSDomainsProviderModel{Profile: types.StringNull()}); len(got) != 0 {
+		t.Errorf("Expected no options when unset, got %d", len(got))
+	}
+}
+
+// isolateAWSEnv clears the AWS region and profile environment variables and
+// points the SDK at a shared config file with the given contents.
+func isolateAWSEnv(t *testing.T, sharedConfig string) {
+	t.Helper()
+	for _, env := range []string{"AWS_REGION", "AWS_DEFAULT_REGION", "AWS_PROFILE", "AWS_DEFAULT_PROFILE"} {
+		t.Setenv(env, "")
+	}
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config")
+	if err := os.WriteFile(configFile, []byte(sharedConfig), 0o600); err != nil {
+		t.Fatalf("Could not write shared config: %s", err)
+	}
+	t.Setenv("AWS_CONFIG_FILE", configFile)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+}
+
+const testSharedConfig = `[default]
+region = eu-west-1
+
+[profile gov]
+region = us-gov-west-1
+
+[profile china]
+region = cn-northwest-1
+`
+
+const testSSOConfig = `[profile sso]
+sso_session = corp
+sso_account_id = 123456789012
+sso_role_name = DomainsAdmin
+region = us-east-1
+
+[sso-session corp]
+sso_start_url = https://corp.awsapps.com/start
+sso_region = us-east-1
+`
+
+// writeSSOToken caches an access token for the corp sso-session, expiring at
+// expiresAt, where the SDK looks for it under HOME.
+func writeSSOToken(t *testing.T, expiresAt time.Time) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	cacheFile, err := ssocreds.StandardCachedTokenFilepath("corp")
+	if err != nil {
+		t.Fatalf("Could not resolve SSO token cache: %s", err)
+	}
+	token := fmt.Sprintf(`{"accessToken": "cached-token", "expiresAt": %q}`, expiresAt.UTC().Format(time.RFC3339))
+	if err := os.MkdirAll(filepath.Dir(cacheFile), 0o700); err != nil {
+		t.Fatalf("Could not create SSO token cache: %s", err)
+	}
+	if err := os.WriteFile(cacheFile, []byte(token), 0o600); err != nil {
+		t.Fatalf("Could not write SSO token: %s", err)
+	}
+}
+
+func TestSharedConfigOptions_ssoProfile(t *testing.T) {
+	isolateAWSEnv(t, testSSOConfig)
+	writeSSOToken(t, time.Now().Add(time.Hour))
+
+	var bearer string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearer = r.Header.Get("x-amz-sso_bearer_token")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"roleCredentials": {"accessKeyId": "ASIASSO", "secretAccessKey": "secret", "sessionToken": "session", "expiration": %d}}`,
+			time.Now().Add(time.Hour).UnixMilli())
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_SSO", server.URL)
+
+	ctx := context.Background()
+	cfg, err := config.LoadDefaultConfig(ctx, sharedConfigOptions(AWSDomainsProviderModel{Profile: types.StringValue("sso")})...)
+	if err != nil {
+		t.Fatalf("Could not load SSO profile: %s", err)
+	}
+	if cache, ok := cfg.Credentials.(*aws.CredentialsCache); !ok || !cache.IsCredentialsProvider(&ssocreds.Provider{}) {
+		t.Fatalf("Expected SSO credentials provider, got %T", cfg.Credentials)
+	}
+
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("Could not retrieve SSO credentials: %s", err)
+	}
+	if creds.AccessKeyID != "ASIASSO" {
+		t.Errorf("Expected role credentials ASIASSO, got %q", creds.AccessKeyID)
+	}
+	if bearer != "cached-token" {
+		t.Errorf("Expected the cached SSO token to be sent, got %q", bearer)
+	}
+}
+
+func TestCredentialsHint_expiredSSOToken(t *testing.T) {
+	isolateAWSEnv(t, testSSOConfig)
+	writeSSOToken(t, time.Now().Add(-time.Hour))
+
+	ctx := context.Background()
+	cfg, err := config.LoadDefaultConfig(ctx, sharedConfigOptions(AWSDomainsProviderModel{Profile: types.StringValue("sso")})...)
+	if err != nil {
+		t.Fatalf("Could not load SSO profile: %s", err)
+	}
+	_, err = cfg.Credentials.Retrieve(ctx)
+	if err == nil {
+		t.Fatal("Expected an error for an expired SSO token")
+	}
+
+	if hint := credentialsHint(err, "sso"); !strings.Contains(hint, `"aws sso login --profile sso"`) {
+		t.Errorf("Expected an aws sso login hint, got %q", hint)
+	}
+	if hint := credentialsHint(&ssocreds.InvalidTokenError{}, ""); !strings.Contains(hint, `"aws sso login"`) {
+		t.Errorf("Expected an aws sso login hint for the default profile, got %q", hint)
+	}
+	if hint := credentialsHint(errors.New("connection refused"), "sso"); strings.Contains(hint, "sso login") {
+		t.Errorf("Expected no SSO hint for other errors, got %q", hint)
+	}
+}
+
+func TestResolveRegion(t *testing.T) {
+	tests := []struct {
+		name         string
+		region       types.String
+		profile      types.String
+		env          map[string]string
+		sharedConfig string
+		wantRegion   string
+		wantSource   string
+	}{
+		{"argument over environment", types.StringValue("us-east-1"), types.StringNull(), map[string]string{"AWS_REGION": "us-gov-west-1", "AWS_DEFAULT_REGION": "cn-northwest-1"}, testSharedConfig, "us-east-1", "region"},
+		{"AWS_REGION over AWS_DEFAULT_REGION", types.StringNull(), types.StringNull(), map[string]string{"AWS_REGION": "us-gov-west-1", "AWS_DEFAULT_REGION": "cn-northwest-1"}, testSharedConfig, "us-gov-west-1", "AWS_REGION"},
+		{"AWS_DEFAULT_REGION over shared config", types.StringNull(), types.StringNull(), map[string]string{"AWS_DEFAULT_REGION": "cn-northwest-1"}, testSharedConfig, "cn-northwest-1", "AWS_DEFAULT_REGION"},
+		{"environment over profile argument", types.StringNull(), types.StringValue("china"), map[string]string{"AWS_REGION": "us-gov-west-1"}, testSharedConfig, "us-gov-west-1", "AWS_REGION"},
+		{"shared config default profile", types.StringNull(), types.StringNull(), nil, testSharedConfig, "eu-west-1", "shared config"},
+		{"AWS_PROFILE selects profile", types.StringNull(), types.StringNull(), map[string]string{"AWS_PROFILE": "gov"}, testSharedConfig, "us-gov-west-1", "shared config"},
+		{"profile argument over AWS_PROFILE", types.StringNull(), types.StringValue("china"), map[string]string{"AWS_PROFILE": "gov"}, testSharedConfig, "cn-northwest-1", "shared config"},
+		{"nothing set", types.StringNull(), types.StringNull(), nil, "", "", "shared config"},
+		{"unknown argument", types.StringUnknown(), types.StringNull(), map[string]string{"AWS_REGION": "us-gov-west-1"}, testSharedConfig, "", "region"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateAWSEnv(t, tt.sharedConfig)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			region, source, err := resolveRegion(context.Background(), AWSDomainsProviderModel{Region: tt.region, Profile: tt.profile})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if region != tt.wantRegion || source != tt.wantSource {
+				t.Errorf("Expected region %q from %s, got %q from %s", tt.wantRegion, tt.wantSource, region, source)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_environmentRegion(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        map[string]string
+		wantRegion string
+	}{
+		{"govcloud from AWS_REGION", map[string]string{"AWS_REGION": "us-gov-east-1"}, "us-gov-west-1"},
+		{"china from AWS_DEFAULT_REGION", map[string]string{"AWS_DEFAULT_REGION": "cn-north-1"}, "cn-northwest-1"},
+		{"other commercial region", map[string]string{"AWS_REGION": "eu-west-1"}, "us-east-1"},
+		{"shared config region", nil, "us-east-1"},
+		{"profile from AWS_PROFILE", map[string]string{"AWS_PROFILE": "gov"}, "us-gov-west-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateAWSEnv(t, testSharedConfig)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			ctx := context.Background()
+			p := New("test")()
+
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+			s := schemaResp.Schema
+
+			raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := raw.Set(ctx, &AWSDomainsProviderModel{
+				Region:                 types.StringNull(),
+				Profile:                types.StringNull(),
+				Route53DomainsEndpoint: types.StringNull(),
+				Route53Endpoint:        types.StringNull(),
+				MaxRetries:             types.Int64Null(),
+			}); diags.HasError() {
+				t.Fatalf("Could not build config: %v", diags)
+			}
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected a region from the environment not to fail, got %v", resp.Diagnostics)
+			}
+			client := resp.ResourceData.(*ProviderData).DomainsClient.(*route53domains.Client)
+			if got := client.Options().Region; got != tt.wantRegion {
+				t.Errorf("Expected region %s, got %s", tt.wantRegion, got)
+			}
+		})
+	}
+}
+
+func TestNewAWSClientsEndpointOverrides(t *testing.T) {
+	cfg := aws.Config{Region: "us-east-1"}
+
+	domainsClient, route53Client := newAWSClients(cfg, AWSDomainsProviderModel{
+		Route53DomainsEndpoint: types.StringValue("http://localhost:4566"),
+		Route53Endpoint:        types.StringValue("http://localhost:4567"),
+	})
+	if got := aws.ToString(domainsClient.Options().BaseEndpoint); got != "http://localhost:4566" {
+		t.Errorf("Expected route53domains endpoint http://localhost:4566, got %q", got)
+	}
+	if got := aws.ToString(route53Client.Options().BaseEndpoint); got != "http://localhost:4567" {
+		t.Errorf("Expected route53 endpoint http://localhost:4567, got %q", got)
+	}
+
+	domainsClient, route53Client = newAWSClients(cfg, AWSDomainsProviderModel{
+		Route53DomainsEndpoint: types.StringNull(),
+		Route53Endpoint:        types.StringNull(),
+	})
+	if domainsClient.Options().BaseEndpoint != nil || route53Client.Options().BaseEndpoint != nil {
+		t.Error("Expected no endpoint override when endpoints are unset")
+	}
+}
+
+func TestProviderConfigure_rejectsOtherRegions(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := raw.Set(ctx, &AWSDomainsProviderModel{
+		Region:                 types.StringValue("eu-west-1"),
+		Profile:                types.StringNull(),
+		Route53DomainsEndpoint: types.StringNull(),
+		Route53Endpoint:        types.StringNull(),
+	}); diags.HasError() {
+		t.Fatalf("Could not build config: %v", diags)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a region other than us-east-1")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Unsupported region" {
+		t.Errorf("Unexpected diagnostic summary: %s", summary)
+	}
+	if resp.ResourceData != nil || resp.DataSourceData != nil {
+		t.Error("Expected no provider data after a configuration error")
+	}
+}
+
+func TestProviderConfigure_unknownRegion(t *testing.T) {
+	isolateAWSEnv(t, "")
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := raw.Set(ctx, &AWSDomainsProviderModel{
+		Region:                 types.StringUnknown(),
+		Profile:                types.StringNull(),
+		Route53DomainsEndpoint: types.StringNull(),
+		Route53Endpoint:        types.StringNull(),
+		MaxRetries:             types.Int64Null(),
+	}); diags.HasError() {
+		t.Fatalf("Could not build config: %v", diags)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected an unknown region to be accepted, got %v", resp.Diagnostics)
+	}
+	if resp.ResourceData == nil || resp.DataSourceData == nil {
+		t.Error("Expected provider data to be set")
+	}
+}
+
+func TestDomainsEndpointPerPartition(t *testing.T) {
+	want := map[string]string{
+		"aws":        "route53domains.us-east-1.amazonaws.com",
+		"aws-us-gov": "route53domains.us-gov-west-1.amazonaws.com",
+		"aws-cn":     "route53domains.cn-northwest-1.amazonaws.com.cn",
+	}
+	for partition, host := range want {
+		t.Run(partition, func(t *testing.T) {
+			region := partitionDomainsRegions[partition]
+			if got := partitionForRegion(region); got != partition {
+				t.Errorf("Expected %s to belong to %s, got %s", region, partition, got)
+			}
+
+			domainsClient, _ := newAWSClients(aws.Config{Region: region}, AWSDomainsProviderModel{})
+			endpoint, err := domainsClient.Options().EndpointResolverV2.ResolveEndpoint(context.Background(), route53domains.EndpointParameters{
+				Region: aws.String(domainsClient.Options().Region),
+			})
+			if err != nil {
+				t.Fatalf("Could not resolve endpoint: %s", err)
+			}
+			if endpoint.URI.Host != host {
+				t.Errorf("Expected endpoint host %s, got %s", host, endpoint.URI.Host)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_partition(t *testing.T) {
+	tests := []struct {
+		name        string
+		partition   types.String
+		region      types.String
+		wantRegion  string
+		wantSummary string
+	}{
+		{"default", types.StringNull(), types.StringNull(), "us-east-1", ""},
+		{"govcloud partition", types.StringValue("aws-us-gov"), types.StringNull(), "us-gov-west-1", ""},
+		{"china from region", types.StringNull(), types.StringValue("cn-northwest-1"), "cn-northwest-1", ""},
+		{"wrong region for partition", types.StringValue("aws-us-gov"), types.StringValue("us-east-1"), "", "Unsupported region"},
+		{"unknown partition name", types.StringValue("aws-iso"), types.StringNull(), "", "Unsupported partition"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateAWSEnv(t, "")
+			ctx := context.Background()
+			p := New("test")()
+
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+			s := schemaResp.Schema
+
+			raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := raw.Set(ctx, &AWSDomainsProviderModel{
+				Region:                 tt.region,
+				Partition:              tt.partition,
+				Profile:                types.StringNull(),
+				Route53DomainsEndpoint: types.StringNull(),
+				Route53Endpoint:        types.StringNull(),
+				MaxRetries:             types.Int64Null(),
+			}); diags.HasError() {
+				t.Fatalf("Could not build config: %v", diags)
+			}
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+
+			if tt.wantSummary != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.wantSummary {
+					t.Fatalf("Expected a %q error, got %v", tt.wantSummary, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+			}
+			client := resp.ResourceData.(*ProviderData).DomainsClient.(*route53domains.Client)
+			if got := client.Options().Region; got != tt.wantRegion {
+				t.Errorf("Expected region %s, got %s", tt.wantRegion, got)
+			}
+		})
+	}
 }
 
 func TestProviderMetadata(t *testing.T) {
@@ -54,3 +580,280 @@ func TestProviderMetadata(t *testing.T) {
 		t.Errorf("Expected Version '1.0.0', got '%s'", resp.Version)
 	}
 }
+
+func TestProviderDataConfiguresResourcesAndDataSources(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+	providerData := &ProviderData{
+		DomainsClient: &MockRoute53DomainsClient{},
+		Route53Client: &MockRoute53Client{},
+		PriceCache:    NewPriceCache(defaultPriceCacheTTL),
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "awsdomains"}, metadata)
+
+		configurable, ok := r.(resource.ResourceWithConfigure)
+		if !ok {
+			t.Errorf("%s does not implement Configure", metadata.TypeName)
+			continue
+		}
+		resp := &resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: Configure with *ProviderData returned errors: %v", metadata.TypeName, resp.Diagnostics)
+		}
+
+		resp = &resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData.DomainsClient}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected an error for provider data of the wrong type", metadata.TypeName)
+		}
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		metadata := &datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "awsdomains"}, metadata)
+
+		configurable, ok := d.(datasource.DataSourceWithConfigure)
+		if !ok {
+			t.Errorf("%s does not implement Configure", metadata.TypeName)
+			continue
+		}
+		resp := &datasource.ConfigureResponse{}
+		configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: providerData}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: Configure with *ProviderData returned errors: %v", metadata.TypeName, resp.Diagnostics)
+		}
+
+		resp = &datasource.ConfigureResponse{}
+		configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: providerData.DomainsClient}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected an error for provider data of the wrong type", metadata.TypeName)
+		}
+	}
+}
+
+func TestProviderConfigure_sharesProviderData(t *testing.T) {
+	isolateAWSEnv(t, "")
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := raw.Set(ctx, &AWSDomainsProviderModel{}); diags.HasError() {
+		t.Fatalf("Could not build config: %v", diags)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+
+	resourceData, ok := resp.ResourceData.(*ProviderData)
+	if !ok {
+		t.Fatalf("Expected *ProviderData for resources, got %T", resp.ResourceData)
+	}
+	if resp.DataSourceData != resourceData {
+		t.Errorf("Expected resources and data sources to share one *ProviderData, got %T", resp.DataSourceData)
+	}
+}
+
+func TestProviderConfigure_defaultTags(t *testing.T) {
+	isolateAWSEnv(t, "")
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := raw.Set(ctx, &AWSDomainsProviderModel{DefaultTags: &DefaultTagsModel{Tags: map[string]types.String{
+		"team":  types.StringValue("platform"),
+		"build": types.StringUnknown(),
+	}}}); diags.HasError() {
+		t.Fatalf("Could not build config: %v", diags)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+
+	r := &DomainRegistrationResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: resp.ResourceData}, &resource.ConfigureResponse{})
+	if want := map[string]string{"team": "platform"}; !maps.Equal(r.defaultTags, want) {
+		t.Errorf("Expected default tags %v, got %v", want, r.defaultTags)
+	}
+}
+
+func TestProviderConfigure_validateCredentials(t *testing.T) {
+	tests := []struct {
+		name      string
+		validate  bool
+		status    int
+		body      string
+		wantCalls int
+		wantErr   string
+	}{
+		{"disabled", false, http.StatusBadRequest, `{"__type":"AccessDeniedException"}`, 0, ""},
+		{"valid", true, http.StatusOK, `{"Prices":[]}`, 1, ""},
+		{"access denied", true, http.StatusBadRequest, `{"__type":"AccessDeniedException","message":"User is not authorized to perform: route53domains:ListPrices"}`, 1, "lack route53domains:ListPrices"},
+		{"bad key", true, http.StatusBadRequest, `{"__type":"UnrecognizedClientException","message":"The security token included in the request is invalid."}`, 1, "Check the access key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateAWSEnv(t, "")
+			t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+			t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+			var targets []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				targets = append(targets, r.Header.Get("X-Amz-Target"))
+				w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			p := New("test")()
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+			s := schemaResp.Schema
+
+			raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := raw.Set(ctx, &AWSDomainsProviderModel{
+				Route53DomainsEndpoint: types.StringValue(server.URL),
+				MaxRetries:             types.Int64Value(0),
+				ValidateCredentials:    types.BoolValue(tt.validate),
+			}); diags.HasError() {
+				t.Fatalf("Could not build config: %v", diags)
+			}
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+
+			if len(targets) != tt.wantCalls {
+				t.Fatalf("Expected %d calls, got %v", tt.wantCalls, targets)
+			}
+			if tt.wantCalls > 0 && targets[0] != "Route53Domains_v20140515.ListPrices" {
+				t.Errorf("Expected a ListPrices call, got %s", targets[0])
+			}
+			if tt.wantErr == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected Configure to fail")
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, tt.wantErr) {
+				t.Errorf("Expected the error to contain %q, got %q", tt.wantErr, detail)
+			}
+			if resp.ResourceData != nil {
+				t.Error("Expected no provider data after a failed validation")
+			}
+		})
+	}
+}
+
+// configureTestProvider runs the provider's Configure with the given model,
+// with AWS credentials and shared config isolated from the environment, and
+// returns the data handed to resources and data sources.
+func configureTestProvider(t *testing.T, data *AWSDomainsProviderModel) *ProviderData {
+	t.Helper()
+	isolateAWSEnv(t, "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	ctx := context.Background()
+	p := New("test")()
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := raw.Set(ctx, data); diags.HasError() {
+		t.Fatalf("Could not build config: %v", diags)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: raw.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+	return resp.DataSourceData.(*ProviderData)
+}
+
+// newDataSourceReadRequest builds a ReadRequest whose config is populated from
+// the given model, along with an empty ReadResponse, for unit testing a data
+// source's Read against a mock client.
+func newDataSourceReadRequest(t *testing.T, d datasource.DataSource, config any) (datasource.ReadRequest, *datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned errors: %v", schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+
+	raw := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := raw.Set(ctx, config); diags.HasError() {
+		t.Fatalf("Could not build config: %v", diags)
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: s, Raw: raw.Raw},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	return req, resp
+}
+
+// testResourceSchema returns the schema of the given resource.
+func testResourceSchema(t *testing.T, r resource.Resource) rschema.Schema {
+	t.Helper()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned errors: %v", schemaResp.Diagnostics)
+	}
+	return schemaResp.Schema
+}
+
+// newResourceState builds a resource state populated from the given model. A
+// nil model produces an empty (null) state, as passed to Create.
+func newResourceState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	s := testResourceSchema(t, r)
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if model != nil {
+		if diags := state.Set(ctx, model); diags.HasError() {
+			t.Fatalf("Could not build state: %v", diags)
+		}
+	}
+	return state
+}
+
+// newResourcePlan builds a resource plan populated from the given model.
+func newResourcePlan(t *testing.T, r resource.Resource, model any) tfsdk.Plan {
+	t.Helper()
+	state := newResourceState(t, r, model)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SupportedTLDsDataSource{}
+
+type SupportedTLDsDataSource struct {
+	client     Route53DomainsAPI
+	priceCache *PriceCache
+}
+
+type SupportedTLDsDataSourceModel struct {
+	ID   types.String        `tfsdk:"id"`
+	TLDs []SupportedTLDModel `tfsdk:"tlds"`
+}
+
+type SupportedTLDModel struct {
+	TLD               types.String  `tfsdk:"tld"`
+	RegistrationPrice types.Float64 `tfsdk:"registration_price"`
+	RenewalPrice      types.Float64 `tfsdk:"renewal_price"`
+	TransferPrice     types.Float64 `tfsdk:"transfer_price"`
+	Currency          types.String  `tfsdk:"currency"`
+}
+
+func NewSupportedTLDsDataSource() datasource.DataSource {
+	return &SupportedTLDsDataSource{}
+}
+
+func (d *SupportedTLDsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supported_tlds"
+}
+
+func (d *SupportedTLDsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List every TLD that Route53 can register, with its prices.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Static identifier for this data source.",
+			},
+			"tlds": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Supported TLDs in the order returned by AWS.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tld": schema.StringAttribute{
+							Computed:    true,
+							Description: "The top-level domain (e.g., 'com').",
+						},
+						"registration_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to register a new domain.",
+						},
+						"renewal_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to renew a domain.",
+						},
+						"transfer_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Price to transfer a domain.",
+						},
+						"currency": schema.StringAttribute{
+							Computed:    true,
+							Description: "Currency code of the registration price (e.g., USD).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SupportedTLDsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.DomainsClient
+	d.priceCache = providerData.PriceCache
+}
+
+func (d *SupportedTLDsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SupportedTLDsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The listing is shared with awsdomains_domain_price through the provider's cache
+	prices, err := d.priceCache.Prices(ctx, func(ctx context.Context) ([]awstypes.DomainPrice, error) {
+		return listAllPrices(ctx, d.client)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing domain prices",
+			fmt.Sprintf("Could not list supported TLDs: %s", err.Error()),
+		)
+		return
+	}
+
+	tlds := []SupportedTLDModel{}
+	for _, price := range prices {
+		if price.Name == nil {
+			continue
+		}
+		tld := SupportedTLDModel{
+			TLD: types.StringPointerValue(price.Name),
+		}
+		tld.RegistrationPrice, tld.Currency = priceWithCurrency(price.RegistrationPrice)
+		tld.RenewalPrice, _ = priceWithCurrency(price.RenewalPrice)
+		tld.TransferPrice, _ = priceWithCurrency(price.TransferPrice)
+		tlds = append(tlds, tld)
+	}
+
+	data.ID = types.StringValue("supported_tlds")
+	data.TLDs = tlds
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSupportedTLDsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSupportedTLDsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.awsdomains_supported_tlds.test", "tlds.*", map[string]string{
+						"tld":      "com",
+						"currency": "USD",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSupportedTLDsDataSourceConfig() string {
+	return `
+provider "awsdomains" {
+  region = "us-east-1"
+}
+
+data "awsdomains_supported_tlds" "test" {}
+`
+}
+
+func TestSupportedTLDsDataSourceRead_paginates(t *testing.T) {
+	ctx := context.Background()
+	var markers []string
+	d := &SupportedTLDsDataSource{
+		client: &MockRoute53DomainsClient{
+			ListPricesFunc: func(ctx context.Context, params *route53domains.ListPricesInput, optFns ...func(*route53domains.Options)) (*route53domains.ListPricesOutput, error) {
+				if params.Tld != nil {
+					t.Errorf("Expected no TLD filter, got %s", *params.Tld)
+				}
+				markers = append(markers, aws.ToString(params.Marker))
+				if params.Marker == nil {
+					return &route53domains.ListPricesOutput{
+						Prices: []types.DomainPrice{
+							{
+								Name:              aws.String("com"),
+								RegistrationPrice: &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+								RenewalPrice:      &types.PriceWithCurrency{Price: 15, Currency: aws.String("USD")},
+								TransferPrice:     &types.PriceWithCurrency{Price: 14, Currency: aws.String("USD")},
+							},
+						},
+						NextPageMarker: aws.String("page-2"),
+					}, nil
+				}
+				return &route53domains.ListPricesOutput{
+					Prices: []types.DomainPrice{
+						{
+							Name:              aws.String("net"),
+							RegistrationPrice: &types.PriceWithCurrency{Price: 16, Currency: aws.String("USD")},
+						},
+					},
+				}, nil
+			},
+		},
+	}
+
+	req, resp := newDataSourceReadRequest(t, d, &SupportedTLDsDataSourceModel{})
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	if len(markers) != 2 || markers[1] != "page-2" {
+		t.Errorf("Expected two pages with the second requested by marker, got %v", markers)
+	}
+
+	var state SupportedTLDsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Could not read state: %v", resp.Diagnostics)
+	}
+
+	if len(state.TLDs) != 2 {
+		t.Fatalf("Expected 2 TLDs, got %d", len(state.TLDs))
+	}
+	com := state.TLDs[0]
+	if com.TLD.ValueString() != "com" || com.RegistrationPrice.ValueFloat64() != 14 || com.RenewalPrice.ValueFloat64() != 15 || com.Currency.ValueString() != "USD" {
+		t.Errorf("Unexpected com entry: %+v", com)
+	}
+	net := state.TLDs[1]
+	if net.TLD.ValueString() != "net" || !net.RenewalPrice.IsNull() || !net.TransferPrice.IsNull() {
+		t.Errorf("Expected net entry with null renewal and transfer prices, got %+v", net)
+	}
+}
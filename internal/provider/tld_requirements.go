@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tldRequirement describes the registrant extra params a TLD's registry needs.
+// Missing Required params fail the registration, so they are errors. Missing
+// Recommended params are only needed in some situations and are warned about,
+// with Note, whenever When reports the registrant may need them (or always,
+// without a When). Check, when set, compares the params that are present with
+// the rest of the registrant contact and returns the param and a description
+// of any inconsistency the registry would reject. NoBillingContact marks
+// registries without a billing contact, for which billingFallback carries the
+// billing contact's extra params on the registrant instead.
+type tldRequirement struct {
+	Required         []string
+	Recommended      []string
+	Note             string
+	When             func(registrant *ContactModel) bool
+	Check            func(registrant *ContactModel) (param, problem string)
+	NoBillingContact bool
+}
+
+// tldRequirements lists the extra params of common TLDs that Route 53
+// documents as required. It is not exhaustive: TLDs missing from it are not
+// checked.
+var tldRequirements = map[string]tldRequirement{
+	"com.au": {Required: []string{"AU_ID_NUMBER", "AU_ID_TYPE"}, NoBillingContact: true},
+	"net.au": {Required: []string{"AU_ID_NUMBER", "AU_ID_TYPE"}, NoBillingContact: true},
+	"ca":     {Required: []string{"CA_LEGAL_TYPE"}, Check: checkCALegalType, NoBillingContact: true},
+	"es":     {Required: []string{"ES_IDENTIFICATION", "ES_IDENTIFICATION_TYPE", "ES_LEGAL_FORM"}},
+	"se":     {Required: []string{"SE_ID_NUMBER"}},
+	"sg":     {Required: []string{"SG_ID_NUMBER"}},
+	"com.sg": {Required: []string{"SG_ID_NUMBER"}},
+	"eu": {
+		Recommended: []string{"EU_COUNTRY_OF_CITIZENSHIP"},
+		Note:        "A .eu registrant must reside in the EU or EEA, or be an EU citizen identified by EU_COUNTRY_OF_CITIZENSHIP.",
+		When: func(registrant *ContactModel) bool {
+			return !slices.Contains(eeaCountryCodes, strings.ToUpper(registrant.CountryCode.ValueString()))
+		},
+		NoBillingContact: true,
+	},
+}
+
+// eeaCountryCodes are the EU and EEA countries whose residents may register
+// .eu names.
+var eeaCountryCodes = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU", "IE", "IS",
+	"IT", "LI", "LT", "LU", "LV", "MT", "NL", "NO", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+// caLegalTypes are the CA_LEGAL_TYPE values CIRA accepts. Those mapped to
+// true describe registrants that exist only in Canada, such as permanent
+// residents, Canadian governments and hospitals, and so need a Canadian
+// address. The others, such as citizens (CCT) and trademark owners (TDM), may
+// be located anywhere.
+var caLegalTypes = map[string]bool{
+	"ABO": false, "ASS": false, "CCO": false, "CCT": false, "EDU": true, "GOV": true,
+	"HOP": true, "INB": false, "LAM": true, "LGR": false, "MAJ": true, "OMK": false,
+	"PLT": true, "PRT": false, "RES": true, "TDM": false, "TRD": false, "TRS": false,
+}
+
+// checkCALegalType reports a CA_LEGAL_TYPE that CIRA does not accept, or one
+// limited to Canadian registrants given with a country code other than CA.
+func checkCALegalType(registrant *ContactModel) (string, string) {
+	legalType, ok := registrant.ExtraParams["CA_LEGAL_TYPE"]
+	if !ok || legalType.IsNull() || legalType.IsUnknown() {
+		return "", ""
+	}
+
+	value := strings.ToUpper(legalType.ValueString())
+	inCanada, known := caLegalTypes[value]
+	if !known {
+		return "CA_LEGAL_TYPE", fmt.Sprintf("CA_LEGAL_TYPE %q is not a legal type CIRA accepts.", legalType.ValueString())
+	}
+	country := registrant.CountryCode
+	if inCanada && !country.IsNull() && !country.IsUnknown() && !strings.EqualFold(country.ValueString(), "CA") {
+		return "CA_LEGAL_TYPE", fmt.Sprintf("CA_LEGAL_TYPE %s is only for registrants in Canada, but the registrant's country_code is %s. Set country_code to CA or choose a legal type that allows registrants abroad, such as CCT or TDM.", value, country.ValueString())
+	}
+	return "", ""
+}
+
+// tldRequirementFor returns the requirement for the longest listed TLD the
+// domain ends with, so example.com.au matches com.au rather than any au entry.
+func tldRequirementFor(domainName string) (string, tldRequirement, bool) {
+	domain := strings.TrimSuffix(canonicalDomainName(domainName), ".")
+	best := ""
+	for tld := range tldRequirements {
+		if strings.HasSuffix(domain, "."+tld) && len(tld) > len(best) {
+			best = tld
+		}
+	}
+	if best == "" {
+		return "", tldRequirement{}, false
+	}
+	return best, tldRequirements[best], true
+}
+
+// billingFallback returns the billing contact's extra params that are sent on
+// the registrant contact, as the domain's registry has no billing contact to
+// hold them. Params the registrant sets itself win. It returns nil when the
+// registry has a billing contact or there is nothing to carry.
+func billingFallback(domainName string, registrant, billing *ContactModel) map[string]tftypes.String {
+	if registrant == nil || billing == nil || len(billing.ExtraParams) == 0 {
+		return nil
+	}
+	if _, requirement, ok := tldRequirementFor(domainName); !ok || !requirement.NoBillingContact {
+		return nil
+	}
+	var params map[string]tftypes.String
+	for name, value := range billing.ExtraParams {
+		if _, ok := registrant.ExtraParams[name]; ok {
+			continue
+		}
+		if params == nil {
+			params = map[string]tftypes.String{}
+		}
+		params[name] = value
+	}
+	return params
+}
+
+// registrantWithBilling returns the registrant contact to send to AWS: the
+// registrant with the billingFallback params added.
+func registrantWithBilling(domainName string, registrant, billing *ContactModel) *ContactModel {
+	params := billingFallback(domainName, registrant, billing)
+	if params == nil {
+		return registrant
+	}
+	merged := *registrant
+	merged.ExtraParams = maps.Clone(registrant.ExtraParams)
+	if merged.ExtraParams == nil {
+		merged.ExtraParams = map[string]tftypes.String{}
+	}
+	maps.Copy(merged.ExtraParams, params)
+	return &merged
+}
+
+// validateExtraParams checks the registrant contact against the extra params
+// its TLD is known to require, so a registration that would be rejected fails
+// validation instead of the apply.
+func validateExtraParams(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var domainName tftypes.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domain_name"), &domainName)...)
+	if resp.Diagnostics.HasError() || domainName.IsNull() || domainName.IsUnknown() {
+		return
+	}
+
+	tld, requirement, ok := tldRequirementFor(domainName.ValueString())
+	if !ok {
+		return
+	}
+
+	registrant, at, known, diags := registrantContact(ctx, req.Config, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !known || registrant == nil {
+		return
+	}
+
+	if missing := missingExtraParams(registrant, requirement.Required); len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			at.AtName("extra_params"),
+			"Missing required extra params",
+			fmt.Sprintf("Registering a .%s domain requires the registrant extra params %s.", tld, strings.Join(missing, ", ")),
+		)
+	}
+
+	if requirement.Check != nil {
+		if param, problem := requirement.Check(registrant); problem != "" {
+			resp.Diagnostics.AddAttributeError(
+				at.AtName("extra_params").AtMapKey(param),
+				"Inconsistent extra params",
+				fmt.Sprintf("Registering a .%s domain would fail: %s", tld, problem),
+			)
+		}
+	}
+
+	if requirement.When != nil && !requirement.When(registrant) {
+		return
+	}
+	if missing := missingExtraParams(registrant, requirement.Recommended); len(missing) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			at.AtName("extra_params"),
+			"Extra params may be required",
+			fmt.Sprintf("%s Consider setting %s on the registrant contact.", requirement.Note, strings.Join(missing, ", ")),
+		)
+	}
+}
+
+func missingExtraParams(c *ContactModel, names []string) []string {
+	var missing []string
+	for _, name := range names {
+		if _, ok := c.ExtraParams[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TLDRequirementsDataSource{}
+
+// TLDRequirementsDataSource describes a TLD from the curated tldRequirements
+// table, as the Route53 Domains API does not report registry requirements. It
+// makes no API calls.
+type TLDRequirementsDataSource struct{}
+
+type TLDRequirementsDataSourceModel struct {
+	ID                     types.String   `tfsdk:"id"`
+	TLD                    types.String   `tfsdk:"tld"`
+	Listed                 types.Bool     `tfsdk:"listed"`
+	RequiredExtraParams    []types.String `tfsdk:"required_extra_params"`
+	RecommendedExtraParams []types.String `tfsdk:"recommended_extra_params"`
+	Note                   types.String   `tfsdk:"note"`
+	ContactTypes           []types.String `tfsdk:"contact_types"`
+}
+
+func NewTLDRequirementsDataSource() datasource.DataSource {
+	return &TLDRequirementsDataSource{}
+}
+
+func (d *TLDRequirementsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tld_requirements"
+}
+
+func (d *TLDRequirementsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Describe the registrant extra params and contact types a TLD needs, from the provider's curated table of common TLDs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The TLD.",
+			},
+			"tld": schema.StringAttribute{
+				Required:    true,
+				Description: "The top-level domain (e.g., 'ca', 'com.au'). Case and a leading dot are ignored.",
+			},
+			"listed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the TLD is in the curated table. Requirements of unlisted TLDs are unknown, not absent.",
+			},
+			"required_extra_params": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Extra params the registrant contact must set; registrations without them fail validation.",
+			},
+			"recommended_extra_params": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Extra params the registrant contact needs in some situations, described by note.",
+			},
+			"note": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the recommended extra params are needed. Null when there are none.",
+			},
+			"contact_types": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Contact types accepted for contact_type. COMPANY and ASSOCIATION also need organization_name.",
+			},
+		},
+	}
+}
+
+// Configure only checks the provider data, as the data source needs no client.
+func (d *TLDRequirementsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if _, ok := req.ProviderData.(*ProviderData); !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T", req.ProviderData),
+		)
+	}
+}
+
+func (d *TLDRequirementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TLDRequirementsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tld := normalizeTLD(data.TLD.ValueString())
+	requirement, ok := tldRequirements[tld]
+
+	data.ID = types.StringValue(tld)
+	data.Listed = types.BoolValue(ok)
+	data.RequiredExtraParams = stringValues(requirement.Required)
+	data.RecommendedExtraParams = stringValues(requirement.Recommended)
+	data.Note = types.StringNull()
+	if requirement.Note != "" {
+		data.Note = types.StringValue(requirement.Note)
+	}
+	data.ContactTypes = []types.String{}
+	for _, t := range awstypes.ContactType("").Values() {
+		data.ContactTypes = append(data.ContactTypes, types.StringValue(string(t)))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func stringValues(values []string) []types.String {
+	out := make([]types.String, len(values))
+	for i, v := range values {
+		out[i] = types.StringValue(v)
+	}
+	return out
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTLDRequirementsDataSourceRead(t *testing.T) {
+	tests := []struct {
+		tld             string
+		wantListed      bool
+		wantRequired    []string
+		wantRecommended []string
+	}{
+		{".CA", true, []string{"CA_LEGAL_TYPE"}, []string{}},
+		{"com.au", true, []string{"AU_ID_NUMBER", "AU_ID_TYPE"}, []string{}},
+		{"eu", true, []string{}, []string{"EU_COUNTRY_OF_CITIZENSHIP"}},
+		{"com", false, []string{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tld, func(t *testing.T) {
+			ctx := context.Background()
+			d := &TLDRequirementsDataSource{}
+			req, resp := newDataSourceReadRequest(t, d, &TLDRequirementsDataSourceModel{
+				TLD: tftypes.StringValue(tt.tld),
+			})
+			d.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var state TLDRequirementsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.Listed.ValueBool() != tt.wantListed {
+				t.Errorf("Expected listed=%v, got %s", tt.wantListed, state.Listed)
+			}
+			if got := valueStrings(state.RequiredExtraParams); !slices.Equal(got, tt.wantRequired) {
+				t.Errorf("Expected required_extra_params %v, got %v", tt.wantRequired, got)
+			}
+			if got := valueStrings(state.RecommendedExtraParams); !slices.Equal(got, tt.wantRecommended) {
+				t.Errorf("Expected recommended_extra_params %v, got %v", tt.wantRecommended, got)
+			}
+			if (len(tt.wantRecommended) > 0) != !state.Note.IsNull() {
+				t.Errorf("Expected a note only with recommended params, got %s", state.Note)
+			}
+			if got := valueStrings(state.ContactTypes); !slices.Contains(got, "PERSON") || !slices.Contains(got, "COMPANY") {
+				t.Errorf("Expected every contact type, got %v", got)
+			}
+		})
+	}
+}
+
+func valueStrings(values []tftypes.String) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = v.ValueString()
+	}
+	return out
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// emailPattern is deliberately permissive: a local part without whitespace or
+// '@', then a domain with at least one dot. It accepts plus addressing and
+// subdomains and leaves stricter checks to AWS.
+var emailPattern = regexp.MustCompile(`^[^\s@]+@[^\s@.]+(\.[^\s@.]+)+$`)
+
+var _ validator.String = emailValidator{}
+
+// emailValidator rejects values that are clearly not email addresses at plan
+// time instead of failing the RegisterDomain call at apply time.
+type emailValidator struct{}
+
+func (v emailValidator) Description(ctx context.Context) string {
+	return "value must be a valid email address"
+}
+
+func (v emailValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !emailPattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid email address",
+			fmt.Sprintf("%q is not a valid email address.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator requires a positive Go duration string such as "20m".
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as \"20m\" or \"1h30m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%q is not a positive duration; use a value such as \"20m\" or \"1h30m\".", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = recordTypeValidator{}
+
+// recordTypeValidator requires a Route53 record type such as "TXT", in any
+// case. NS and SOA are rejected, as they never keep a zone from being
+// deleted.
+type recordTypeValidator struct{}
+
+func (v recordTypeValidator) Description(ctx context.Context) string {
+	return "value must be a Route53 record type other than NS and SOA, such as \"TXT\""
+}
+
+func (v recordTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v recordTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	recordType := route53types.RRType(strings.ToUpper(req.ConfigValue.ValueString()))
+	if recordType == route53types.RRTypeNs || recordType == route53types.RRTypeSoa || !slices.Contains(recordType.Values(), recordType) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid record type",
+			fmt.Sprintf("%q is not a record type that can be listed here. Expected one of %v other than NS and SOA, which never keep the zone.", req.ConfigValue.ValueString(), recordType.Values()),
+		)
+	}
+}
+
+// hostnameLabelPattern matches one DNS label: letters, digits and hyphens,
+// not starting or ending with a hyphen.
+var hostnameLabelPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+var _ validator.String = hostnameValidator{}
+
+// hostnameValidator requires a fully qualified DNS hostname with at least two
+// labels, such as "ns1.example.net". Internationalized names are checked in
+// their punycode form and a trailing dot is allowed.
+type hostnameValidator struct{}
+
+func (v hostnameValidator) Description(ctx context.Context) string {
+	return "value must be a fully qualified hostname such as \"ns1.example.net\""
+}
+
+func (v hostnameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostnameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !validHostname(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid hostname",
+			fmt.Sprintf("%q is not a valid hostname; use a fully qualified name such as \"ns1.example.net\".", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+func validHostname(name string) bool {
+	name = strings.TrimSuffix(canonicalDomainName(name), ".")
+	if len(name) == 0 || len(name) > 253 {
+		return false
+	}
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !hostnameLabelPattern.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// zipCodeFormat is the postal code format of a country, with an example for
+// diagnostics.
+type zipCodeFormat struct {
+	pattern *regexp.Regexp
+	example string
+}
+
+// zipCodeFormats are the postal code formats of common registrant countries,
+// keyed by country code. They are best effort: codes that do not match only
+// warn, as AWS decides what it accepts.
+var zipCodeFormats = map[string]zipCodeFormat{
+	"US": {regexp.MustCompile(`^\d{5}(-?\d{4})?$`), "12345 or 12345-6789"},
+	"CA": {regexp.MustCompile(`(?i)^[A-Z]\d[A-Z] ?\d[A-Z]\d$`), "A1A 1A1"},
+	"GB": {regexp.MustCompile(`(?i)^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`), "SW1A 1AA"},
+	"AU": {regexp.MustCompile(`^\d{4}$`), "2000"},
+	"DE": {regexp.MustCompile(`^\d{5}$`), "10115"},
+	"FR": {regexp.MustCompile(`^\d{5}$`), "75001"},
+	"NL": {regexp.MustCompile(`(?i)^\d{4} ?[A-Z]{2}$`), "1234 AB"},
+	"JP": {regexp.MustCompile(`^\d{3}-?\d{4}$`), "123-4567"},
+}
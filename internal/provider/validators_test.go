@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tftypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEmailValidator(t *testing.T) {
+	tests := []struct {
+		email string
+		valid bool
+	}{
+		{"admin@example.com", true},
+		{"first.last+domains@example.com", true},
+		{"ops@mail.example.co.uk", true},
+		{"o'brien@example.io", true},
+		{"", false},
+		{"admin", false},
+		{"admin@", false},
+		{"@example.com", false},
+		{"admin@example", false},
+		{"admin@@example.com", false},
+		{"admin @example.com", false},
+		{"admin@example..com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("email"),
+				ConfigValue: tftypes.StringValue(tt.email),
+			}
+			resp := &validator.StringResponse{}
+			emailValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := !resp.Diagnostics.HasError(); got != tt.valid {
+				t.Errorf("email %q: expected valid=%v, got %v", tt.email, tt.valid, got)
+			}
+		})
+	}
+}
+
+func TestEmailValidator_nullAndUnknown(t *testing.T) {
+	for _, v := range []tftypes.String{tftypes.StringNull(), tftypes.StringUnknown()} {
+		resp := &validator.StringResponse{}
+		emailValidator{}.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("email"), ConfigValue: v}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("Expected no error for %s, got %v", v, resp.Diagnostics)
+		}
+	}
+}
+
+func TestHostnameValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"ns1.example.net", true},
+		{"NS-123.awsdns-45.com", true},
+		{"ns1.example.net.", true},
+		{"ns1.bücher.de", true},
+		{"ns1 example com", false},
+		{"ns1", false},
+		{"", false},
+		{"ns1..example.net", false},
+		{"-ns1.example.net", false},
+		{"ns1-.example.net", false},
+		{"ns_1.example.net", false},
+		{"ns1.example.net/", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("nameservers").AtListIndex(0).AtName("name"),
+				ConfigValue: tftypes.StringValue(tt.name),
+			}
+			resp := &validator.StringResponse{}
+			hostnameValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := !resp.Diagnostics.HasError(); got != tt.valid {
+				t.Errorf("hostname %q: expected valid=%v, got %v", tt.name, tt.valid, got)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"20m", true},
+		{"1h30m", true},
+		{"45s", true},
+		{"", false},
+		{"20", false},
+		{"-5m", false},
+		{"0s", false},
+		{"twenty minutes", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("timeouts").AtName("create"),
+				ConfigValue: tftypes.StringValue(tt.value),
+			}
+			resp := &validator.StringResponse{}
+			durationValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := !resp.Diagnostics.HasError(); got != tt.valid {
+				t.Errorf("duration %q: expected valid=%v, got %v", tt.value, tt.valid, got)
+			}
+		})
+	}
+}
+
+func TestRecordTypeValidator(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"TXT", true},
+		{"txt", true},
+		{"CNAME", true},
+		{"NS", false},
+		{"soa", false},
+		{"TEXT", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("deletable_zone_records").AtListIndex(0).AtName("type"),
+				ConfigValue: tftypes.StringValue(tt.value),
+			}
+			resp := &validator.StringResponse{}
+			recordTypeValidator{}.ValidateString(context.Background(), req, resp)
+
+			if got := !resp.Diagnostics.HasError(); got != tt.valid {
+				t.Errorf("record type %q: expected valid=%v, got %v", tt.value, tt.valid, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// errWaitTimeout is returned by Waiter.Wait when no terminal result was
+// polled before the timeout.
+var errWaitTimeout = errors.New("timed out waiting for operation")
+
+// errWaitAttemptsExhausted is returned by Waiter.Wait when the attempt cap is
+// reached first. It wraps errWaitTimeout, as in both cases the wait ended
+// without a terminal result.
+var errWaitAttemptsExhausted = fmt.Errorf("%w: status check limit reached", errWaitTimeout)
+
+// Waiter polls until Poll returns a result Terminal accepts, backing off
+// between polls, and stops early on timeout, after MaxAttempts polls, or
+// when ctx is cancelled. Throttling errors from Poll, which remain once the
+// client's own retries are used up, count as a non-terminal poll rather than
+// ending the wait.
+type Waiter[T any] struct {
+	// InitialDelay is the delay after the first poll.
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between polls. Zero means no cap.
+	MaxDelay time.Duration
+	// Multiplier grows the delay after each poll. Values below 1 keep it
+	// constant.
+	Multiplier float64
+	// Jitter adds up to this fraction of the delay at random, so concurrent
+	// waits do not poll in lockstep.
+	Jitter float64
+	// Timeout bounds the whole wait.
+	Timeout time.Duration
+	// MaxAttempts caps the number of polls. Zero means no cap.
+	MaxAttempts int
+
+	// Poll fetches the current result.
+	Poll func(ctx context.Context) (T, error)
+	// Terminal reports whether a result ends the wait.
+	Terminal func(T) bool
+}
+
+// Wait polls until a terminal result, returning it. On timeout, attempt
+// exhaustion or cancellation the most recent result is returned alongside
+// errWaitTimeout, errWaitAttemptsExhausted or ctx.Err() so callers can report
+// progress. A non-throttling Poll error ends the wait with that error.
+func (w Waiter[T]) Wait(ctx context.Context) (T, error) {
+	deadline := time.NewTimer(w.Timeout)
+	defer deadline.Stop()
+
+	var last T
+	for attempt := 1; ; attempt++ {
+		result, err := w.Poll(ctx)
+		switch {
+		case err == nil:
+			last = result
+			if w.Terminal(result) {
+				return result, nil
+			}
+		case ctx.Err() != nil:
+			return last, ctx.Err()
+		case isThrottlingError(err):
+			tflog.Debug(ctx, "Throttled while waiting, backing off", map[string]interface{}{
+				"attempt": attempt,
+				"error":   err.Error(),
+			})
+		default:
+			return last, err
+		}
+
+		if w.MaxAttempts > 0 && attempt >= w.MaxAttempts {
+			return last, errWaitAttemptsExhausted
+		}
+
+		wait := time.NewTimer(w.jittered(w.delay(attempt)))
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+			return last, ctx.Err()
+		case <-deadline.C:
+			wait.Stop()
+			return last, errWaitTimeout
+		case <-wait.C:
+		}
+	}
+}
+
+// delay returns the delay after the given (1-based) poll, before jitter.
+func (w Waiter[T]) delay(attempt int) time.Duration {
+	d := float64(w.InitialDelay)
+	if w.Multiplier > 1 {
+		d *= math.Pow(w.Multiplier, float64(attempt-1))
+	}
+	if w.MaxDelay > 0 && d > float64(w.MaxDelay) {
+		return w.MaxDelay
+	}
+	return time.Duration(d)
+}
+
+func (w Waiter[T]) jittered(d time.Duration) time.Duration {
+	if jitter := int64(float64(d) * w.Jitter); jitter > 0 {
+		return d + time.Duration(rand.Int64N(jitter))
+	}
+	return d
+}
+
+// isThrottlingError reports whether err is an AWS throttling error.
+func isThrottlingError(err error) bool {
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestWaiterDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		waiter  Waiter[int]
+		attempt int
+		want    time.Duration
+	}{
+		{"constant", Waiter[int]{InitialDelay: time.Second}, 5, time.Second},
+		{"multiplier below 1 is constant", Waiter[int]{InitialDelay: time.Second, Multiplier: 0.5}, 3, time.Second},
+		{"first delay", Waiter[int]{InitialDelay: time.Second, Multiplier: 2}, 1, time.Second},
+		{"exponential", Waiter[int]{InitialDelay: time.Second, Multiplier: 2}, 4, 8 * time.Second},
+		{"fractional multiplier", Waiter[int]{InitialDelay: 2 * time.Second, Multiplier: 1.5}, 3, 4500 * time.Millisecond},
+		{"capped", Waiter[int]{InitialDelay: time.Second, Multiplier: 2, MaxDelay: 5 * time.Second}, 4, 5 * time.Second},
+		{"cap not yet reached", Waiter[int]{InitialDelay: time.Second, Multiplier: 2, MaxDelay: 5 * time.Second}, 3, 4 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.waiter.delay(tt.attempt); got != tt.want {
+				t.Errorf("delay(%d) = %s, want %s", tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaiterJitter(t *testing.T) {
+	w := Waiter[int]{Jitter: 0.5}
+	for range 100 {
+		if d := w.jittered(time.Second); d < time.Second || d >= 1500*time.Millisecond {
+			t.Fatalf("Jittered delay %s outside [1s, 1.5s)", d)
+		}
+	}
+	if d := (Waiter[int]{}).jittered(time.Second); d != time.Second {
+		t.Errorf("Expected no jitter by default, got %s", d)
+	}
+}
+
+// countingWaiter returns a Waiter over the given poll results, terminal once
+// a result reaches 3.
+func countingWaiter(calls *int, results ...func() (int, error)) Waiter[int] {
+	return Waiter[int]{
+		InitialDelay: time.Millisecond,
+		Timeout:      time.Minute,
+		Poll: func(ctx context.Context) (int, error) {
+			i := min(*calls, len(results)-1)
+			*calls++
+			return results[i]()
+		},
+		Terminal: func(n int) bool { return n >= 3 },
+	}
+}
+
+func pollValue(n int) func() (int, error) { return func() (int, error) { return n, nil } }
+
+func TestWaiterWait_terminal(t *testing.T) {
+	calls := 0
+	got, err := countingWaiter(&calls, pollValue(1), pollValue(2), pollValue(3)).Wait(context.Background())
+	if err != nil || got != 3 {
+		t.Fatalf("Expected 3, nil; got %d, %v", got, err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 polls, got %d", calls)
+	}
+}
+
+func TestWaiterWait_throttlingIsRetried(t *testing.T) {
+	throttled := func() (int, error) {
+		return 0, &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+	}
+	calls := 0
+	got, err := countingWaiter(&calls, pollValue(1), throttled, pollValue(3)).Wait(context.Background())
+	if err != nil || got != 3 {
+		t.Fatalf("Expected 3, nil; got %d, %v", got, err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected the throttled poll to be retried, got %d polls", calls)
+	}
+}
+
+func TestWaiterWait_errorEndsWait(t *testing.T) {
+	failure := errors.New("access denied")
+	calls := 0
+	got, err := countingWaiter(&calls, pollValue(1), func() (int, error) { return 0, failure }).Wait(context.Background())
+	if !errors.Is(err, failure) {
+		t.Fatalf("Expected the poll error, got %v", err)
+	}
+	if got != 1 {
+		t.Errorf("Expected the last result 1, got %d", got)
+	}
+}
+
+func TestWaiterWait_attemptCap(t *testing.T) {
+	calls := 0
+	w := countingWaiter(&calls, pollValue(1))
+	w.MaxAttempts = 4
+	got, err := w.Wait(context.Background())
+	if !errors.Is(err, errWaitAttemptsExhausted) || !errors.Is(err, errWaitTimeout) {
+		t.Fatalf("Expected errWaitAttemptsExhausted, got %v", err)
+	}
+	if calls != 4 || got != 1 {
+		t.Errorf("Expected 4 polls ending at 1, got %d polls ending at %d", calls, got)
+	}
+}
+
+func TestWaiterWait_timeout(t *testing.T) {
+	calls := 0
+	w := countingWaiter(&calls, pollValue(1))
+	w.Timeout = 20 * time.Millisecond
+	if _, err := w.Wait(context.Background()); !errors.Is(err, errWaitTimeout) || errors.Is(err, errWaitAttemptsExhausted) {
+		t.Fatalf("Expected errWaitTimeout, got %v", err)
+	}
+}